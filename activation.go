@@ -0,0 +1,131 @@
+package gosxalerter
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// alerterTimeLayout is the layout of the dates printed by alerter, such as
+// "2015-12-22 10:00:00 +0100".
+const alerterTimeLayout = "2006-01-02 15:04:05 -0700"
+
+type Activation struct {
+	Type             ActivationType  // What kind of event dismissed the alert
+	At               time.Time       // When did it happen ?
+	Value            string          // Value of activation
+	DeliveredAt      time.Time       // When displayed ?
+	ValueIndex       int             // Index of the clicked action, when Type is ActivationTypeActionClicked
+	Timeout          time.Duration   // Timeout of the alert, as configured in Options
+	EffectiveTimeout time.Duration   // Timeout applied by the backend, rounded up to its unit
+	AlertID          string          // ID of the activated alert
+	Options          *Options        // Options of the activated alert
+	Raw              json.RawMessage // Answer of the backend, as received
+	Err              error           // Why the alert failed, a *BackendError when the backend failed
+}
+
+// activationJSON is the activation printed by alerter with -json, as
+// encoded by MarshalJSON.
+type activationJSON struct {
+	Type        ActivationType `json:"activationType"`
+	At          string         `json:"activationAt,omitempty"`
+	Value       string         `json:"activationValue,omitempty"`
+	DeliveredAt string         `json:"deliveredAt,omitempty"`
+	ValueIndex  json.Number    `json:"activationValueIndex,omitempty"`
+	AlertID     string         `json:"alertID,omitempty"`
+}
+
+// activationTimeLayouts are the date layouts accepted when decoding an
+// activation, alerter's first.
+var activationTimeLayouts = []string{alerterTimeLayout, time.RFC3339Nano, "2006-01-02 15:04:05"}
+
+// UnmarshalJSON decodes an activation printed by alerter with -json.
+// Dates and action index in an unexpected format are left unset rather
+// than failing the whole activation, which is still available in Raw.
+func (act *Activation) UnmarshalJSON(data []byte) error {
+	var aj struct {
+		Type        ActivationType  `json:"activationType"`
+		At          string          `json:"activationAt"`
+		Value       string          `json:"activationValue"`
+		DeliveredAt string          `json:"deliveredAt"`
+		ValueIndex  json.RawMessage `json:"activationValueIndex"`
+		AlertID     string          `json:"alertID"`
+	}
+	if err := json.Unmarshal(data, &aj); err != nil {
+		return err
+	}
+
+	*act = Activation{
+		Type:        aj.Type,
+		At:          parseActivationTime(aj.At),
+		Value:       aj.Value,
+		DeliveredAt: parseActivationTime(aj.DeliveredAt),
+		ValueIndex:  parseActivationIndex(aj.ValueIndex),
+		AlertID:     aj.AlertID,
+		Raw:         append(json.RawMessage(nil), data...),
+	}
+	return nil
+}
+
+// MarshalJSON encodes the activation as alerter prints it with -json.
+func (act Activation) MarshalJSON() ([]byte, error) {
+	aj := activationJSON{
+		Type:    act.Type,
+		Value:   act.Value,
+		AlertID: act.AlertID,
+	}
+	if !act.At.IsZero() {
+		aj.At = act.At.Format(alerterTimeLayout)
+	}
+	if !act.DeliveredAt.IsZero() {
+		aj.DeliveredAt = act.DeliveredAt.Format(alerterTimeLayout)
+	}
+	if act.Type == ActivationTypeActionClicked {
+		aj.ValueIndex = json.Number(strconv.Itoa(act.ValueIndex))
+	}
+	return json.Marshal(aj)
+}
+
+// TimeToInteraction returns how long the alert was displayed before the
+// activation.
+func (act *Activation) TimeToInteraction() time.Duration {
+	if act.At.IsZero() || act.DeliveredAt.IsZero() {
+		return 0
+	}
+	return act.At.Sub(act.DeliveredAt)
+}
+
+// IsAction tells whether the action labeled label was clicked.
+func (act *Activation) IsAction(label string) bool {
+	return act.Type == ActivationTypeActionClicked && act.Value == label
+}
+
+// Replied tells whether the user replied to the alert, the reply is Value.
+func (act *Activation) Replied() bool {
+	return act.Type == ActivationTypeReplied
+}
+
+// TimedOut tells whether the alert was closed by its Timeout.
+func (act *Activation) TimedOut() bool {
+	return act.Type == ActivationTypeTimeOut
+}
+
+func parseActivationTime(s string) time.Time {
+	for _, layout := range activationTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// parseActivationIndex accepts an index as a JSON number or string.
+func parseActivationIndex(raw json.RawMessage) int {
+	s := strings.Trim(string(raw), `"`)
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return 0
+	}
+	return i
+}
@@ -0,0 +1,291 @@
+package gosxalerter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// AlerterBackend delivers alerts with the alerter binary embedded in this
+// package, see https://github.com/vjeantet/alerter
+type AlerterBackend struct {
+	Path       string // alerter executable, the embedded binary is installed in os.TempDir() when empty
+	Runner     Runner // Runner starting alerter, defaults to ExecRunner
+	TextLimits Limits // Lengths texts are truncated to, such as AlerterLimits, 0 for no truncation
+}
+
+// AlerterLimits are the lengths OSX displays in an alert without expanding
+// it.
+var AlerterLimits = Limits{Title: 40, Subtitle: 40, Message: 200}
+
+type alerterNotification struct {
+	opts       *Options
+	process    Process
+	closing    int32
+	activation chan *Activation
+}
+
+// Deliver starts alerter with opts.
+func (b *AlerterBackend) Deliver(opts *Options) (Notification, error) {
+	args, err := buildCommand(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := b.path()
+	if err != nil {
+		return nil, err
+	}
+
+	process, err := b.runner().Start(path, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	n := &alerterNotification{
+		opts:       opts,
+		process:    process,
+		activation: make(chan *Activation, 1),
+	}
+
+	go func() {
+		cmdBytes, stderr, readErr, waitErr := collect(process)
+		n.activation <- n.decode(cmdBytes, readErr, waitErr, stderr)
+		close(n.activation)
+	}()
+
+	return n, nil
+}
+
+// decode turns the outcome of the alerter process into an Activation.
+func (n *alerterNotification) decode(out []byte, readErr, waitErr error, stderr string) *Activation {
+	fail := func(sentinel, cause error) *Activation {
+		if atomic.LoadInt32(&n.closing) == 1 {
+			// alerter was interrupted by Close
+			return &Activation{Type: ActivationTypeClosed}
+		}
+		return &Activation{
+			Type: ActivationTypeFailed,
+			Err: &BackendError{
+				Err:      sentinel,
+				ExitCode: exitCode(waitErr),
+				Stderr:   stderr,
+				Output:   out,
+				Cause:    cause,
+			},
+		}
+	}
+
+	if readErr != nil {
+		return fail(ErrBackendCrashed, readErr)
+	}
+	if waitErr != nil {
+		return fail(ErrBackendCrashed, waitErr)
+	}
+
+	act, err := DecodeActivation(out, n.opts)
+	if err != nil {
+		return fail(ErrBadActivationPayload, err)
+	}
+	act.EffectiveTimeout = time.Duration(n.opts.TimeoutIn(time.Second)) * time.Second
+	return act
+}
+
+// Validate checks that the sound of opts is an OSX system sound.
+func (b *AlerterBackend) Validate(opts *Options) error {
+	if opts.Sound != "" && !macOSSounds[opts.Sound] {
+		return &FieldError{Field: "Sound", Reason: fmt.Sprintf("unknown sound %q", opts.Sound)}
+	}
+	return nil
+}
+
+// Limits returns TextLimits.
+func (b *AlerterBackend) Limits() Limits {
+	return b.TextLimits
+}
+
+// Preview returns the command line alerter would be started with, the
+// executable first. The embedded binary is not installed.
+func (b *AlerterBackend) Preview(opts *Options) (interface{}, error) {
+	args, err := buildCommand(opts)
+	if err != nil {
+		return nil, err
+	}
+	path := b.Path
+	if path == "" {
+		path = installPath(os.TempDir())
+	}
+	return append([]string{path}, args...), nil
+}
+
+// Remove removes the notifications of a group from the notification center.
+func (b *AlerterBackend) Remove(group string) error {
+	path, err := b.path()
+	if err != nil {
+		return err
+	}
+
+	process, err := b.runner().Start(path, "-remove", group)
+	if err != nil {
+		return err
+	}
+	_, stderr, _, err := collect(process)
+	if err != nil {
+		return &BackendError{Err: ErrBackendCrashed, ExitCode: exitCode(err), Stderr: stderr, Cause: err}
+	}
+	return nil
+}
+
+// exitCode returns the exit code reported by the Wait error of a Process.
+func exitCode(waitErr error) int {
+	if waitErr == nil {
+		return 0
+	}
+	var coded interface{ ExitCode() int }
+	if errors.As(waitErr, &coded) {
+		return coded.ExitCode()
+	}
+	return -1
+}
+
+// collect reads the outputs of process until it exits.
+func collect(process Process) (out []byte, stderr string, readErr, waitErr error) {
+	var errBuf bytes.Buffer
+	stderrDone := make(chan struct{})
+	go func() {
+		io.Copy(&errBuf, process.Stderr())
+		close(stderrDone)
+	}()
+
+	out, readErr = ioutil.ReadAll(process.Stdout())
+	<-stderrDone
+	waitErr = process.Wait()
+	return out, errBuf.String(), readErr, waitErr
+}
+
+func (b *AlerterBackend) runner() Runner {
+	if b.Runner != nil {
+		return b.Runner
+	}
+	return ExecRunner{}
+}
+
+// path returns the alerter executable, installing the embedded binary on
+// first use when Path is empty.
+func (b *AlerterBackend) path() (string, error) {
+	if b.Path != "" {
+		return b.Path, nil
+	}
+	return installDefault()
+}
+
+func (n *alerterNotification) Activations() <-chan *Activation {
+	return n.activation
+}
+
+func (n *alerterNotification) Close() error {
+	atomic.StoreInt32(&n.closing, 1)
+	return n.process.Signal(syscall.SIGINT)
+}
+
+func buildCommand(opts *Options) (arg []string, err error) {
+	commandTuples := make([]string, 0)
+
+	//check required commands
+	if opts.Message == "" {
+		return nil, errors.New("Please specifiy a proper message argument.")
+	} else {
+		commandTuples = append(commandTuples, []string{"-message", opts.Message}...)
+	}
+
+	//add closeLabel if found
+	if opts.CloseLabel != "" {
+		commandTuples = append(commandTuples, []string{"-closeLabel", opts.CloseLabel}...)
+	}
+
+	//add dropdownLabel if found
+	if opts.DropdownLabel != "" {
+		commandTuples = append(commandTuples, []string{"-dropdownLabel", opts.DropdownLabel}...)
+	}
+
+	//add actions if found
+	if len(opts.Actions) > 0 {
+		labels := make([]string, len(opts.Actions))
+		for i, action := range opts.Actions {
+			labels[i] = alerterActionLabel(action)
+		}
+		commandTuples = append(commandTuples, []string{"-actions"}...)
+		commandTuples = append(commandTuples, strings.Join(labels, ","))
+	}
+
+	//add Reply if found
+	if opts.Reply == true {
+		commandTuples = append(commandTuples, []string{"-reply", opts.ReplyPlaceHolder}...)
+	}
+
+	//add timeout if found, alerter takes seconds
+	if timeout := opts.TimeoutIn(time.Second); timeout > 0 {
+		commandTuples = append(commandTuples, []string{"-timeout", strconv.FormatInt(timeout, 10)}...)
+	}
+
+	//add title if found
+	if opts.Title != "" {
+		commandTuples = append(commandTuples, []string{"-title", opts.Title}...)
+	}
+
+	//add subtitle if found
+	if opts.Subtitle != "" {
+		commandTuples = append(commandTuples, []string{"-subtitle", opts.Subtitle}...)
+	}
+
+	//add sound if specified
+	if opts.Sound != "" {
+		commandTuples = append(commandTuples, []string{"-sound", string(opts.Sound)}...)
+	}
+
+	//add group if specified
+	if opts.Group != "" {
+		commandTuples = append(commandTuples, []string{"-group", opts.Group}...)
+	}
+
+	//add appIcon if specified
+	if opts.AppIcon != "" {
+		commandTuples = append(commandTuples, []string{"-appIcon", opts.AppIcon}...)
+	}
+
+	//add contentImage if specified
+	if opts.ContentImage != "" {
+		commandTuples = append(commandTuples, []string{"-contentImage", opts.ContentImage}...)
+	}
+
+	//add sender if specified
+	if opts.Sender != "" {
+		commandTuples = append(commandTuples, []string{"-sender", opts.Sender}...)
+	}
+
+	commandTuples = append(commandTuples, []string{"-json"}...)
+
+	if len(commandTuples) == 0 {
+		return nil, errors.New("Please provide a Message and Type at a minimum.")
+	}
+
+	return commandTuples, nil
+}
+
+// alerterCommaSubstitute replaces commas in action labels, alerter splits
+// -actions on commas and has no escaping. It is displayed like a comma.
+const alerterCommaSubstitute = "\u201a"
+
+// alerterActionLabel returns an action label as displayed by alerter,
+// the Decoder maps it back to the original label.
+func alerterActionLabel(action string) string {
+	return strings.Replace(action, ",", alerterCommaSubstitute, -1)
+}
@@ -0,0 +1,350 @@
+package gosxalerter
+
+import (
+	"errors"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// stubRunner is a Runner playing back a scripted process, and recording
+// the command lines it is asked to start.
+type stubRunner struct {
+	stdout  string
+	stderr  string
+	waitErr error
+	// readErr fails the read of stdout after its content
+	readErr error
+	// interruptible processes keep stdout open until they get a signal,
+	// they then exit with code 130.
+	interruptible bool
+
+	mu       sync.Mutex
+	started  [][]string
+	signals  []os.Signal
+	process  *stubProcess
+	startErr error
+}
+
+// stubExit is the Wait error of a process exiting with a non zero code.
+type stubExit int
+
+func (e stubExit) Error() string { return "exit status " + strconv.Itoa(int(e)) }
+
+func (e stubExit) ExitCode() int { return int(e) }
+
+type stubProcess struct {
+	runner  *stubRunner
+	stdout  io.Reader
+	stderr  io.Reader
+	writer  *io.PipeWriter
+	waitErr error
+}
+
+func (r *stubRunner) Start(name string, args ...string) (Process, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.started = append(r.started, append([]string{name}, args...))
+	if r.startErr != nil {
+		return nil, r.startErr
+	}
+
+	p := &stubProcess{
+		runner:  r,
+		stderr:  strings.NewReader(r.stderr),
+		waitErr: r.waitErr,
+	}
+	var stdout io.Reader = strings.NewReader(r.stdout)
+	if r.readErr != nil {
+		stdout = io.MultiReader(stdout, errReader{r.readErr})
+	}
+	if r.interruptible {
+		pr, pw := io.Pipe()
+		go pw.Write([]byte(r.stdout))
+		stdout, p.writer = pr, pw
+	}
+	p.stdout = stdout
+	r.process = p
+	return p, nil
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+func (p *stubProcess) Stdout() io.Reader { return p.stdout }
+
+func (p *stubProcess) Stderr() io.Reader { return p.stderr }
+
+func (p *stubProcess) Signal(sig os.Signal) error {
+	p.runner.mu.Lock()
+	p.runner.signals = append(p.runner.signals, sig)
+	p.runner.mu.Unlock()
+	if p.writer != nil {
+		p.waitErr = stubExit(130)
+		p.writer.Close()
+	}
+	return nil
+}
+
+func (p *stubProcess) Wait() error { return p.waitErr }
+
+// deliverStub delivers an alert with opts through an AlerterBackend
+// running r, and returns its activation.
+func deliverStub(t *testing.T, r *stubRunner, opts *Options) *Activation {
+	t.Helper()
+	b := &AlerterBackend{Path: "/opt/alerter", Runner: r}
+	n, err := b.Deliver(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case act := <-n.Activations():
+		return act
+	case <-time.After(5 * time.Second):
+		t.Fatal("no activation")
+		return nil
+	}
+}
+
+func TestAlerterBackendCommand(t *testing.T) {
+	r := &stubRunner{
+		stdout: `{"activationType":"actionClicked","activationValue":"Yes` + alerterCommaSubstitute + ` deploy","activationValueIndex":"0"}`,
+	}
+	opts := &Options{
+		Title:         "Deploy",
+		Subtitle:      "api",
+		Message:       "Deploy v1.2 ?",
+		Sound:         SoundHero,
+		Group:         "deploy",
+		Actions:       []string{"Yes, deploy", "No"},
+		DropdownLabel: "Choose",
+		CloseLabel:    "Later",
+		Timeout:       1500 * time.Millisecond,
+	}
+	act := deliverStub(t, r, opts)
+
+	want := []string{"/opt/alerter",
+		"-message", "Deploy v1.2 ?",
+		"-closeLabel", "Later",
+		"-dropdownLabel", "Choose",
+		"-actions", "Yes" + alerterCommaSubstitute + " deploy,No",
+		"-timeout", "2",
+		"-title", "Deploy",
+		"-subtitle", "api",
+		"-sound", "Hero",
+		"-group", "deploy",
+		"-json",
+	}
+	if len(r.started) != 1 || !reflect.DeepEqual(r.started[0], want) {
+		t.Errorf("started %q\nwant %q", r.started, want)
+	}
+	if act.Type != ActivationTypeActionClicked || act.Value != "Yes, deploy" || act.ValueIndex != 0 {
+		t.Errorf("activation %q %q %d, want the Yes, deploy action", act.Type, act.Value, act.ValueIndex)
+	}
+	if act.EffectiveTimeout != 2*time.Second {
+		t.Errorf("effective timeout %s, want 2s", act.EffectiveTimeout)
+	}
+}
+
+func TestAlerterBackendTimeout(t *testing.T) {
+	for _, tt := range []struct {
+		timeout time.Duration
+		arg     string
+		want    time.Duration
+	}{
+		{0, "", 0},
+		{time.Nanosecond, "1", time.Second},
+		{time.Second, "1", time.Second},
+		{1500 * time.Millisecond, "2", 2 * time.Second},
+	} {
+		r := &stubRunner{stdout: "@TIMEOUT\n"}
+		act := deliverStub(t, r, &Options{Message: "hello", Timeout: tt.timeout})
+
+		var arg string
+		for i, a := range r.started[0] {
+			if a == "-timeout" && i+1 < len(r.started[0]) {
+				arg = r.started[0][i+1]
+			}
+		}
+		if arg != tt.arg {
+			t.Errorf("timeout %s: -timeout %q, want %q", tt.timeout, arg, tt.arg)
+		}
+		if act.EffectiveTimeout != tt.want {
+			t.Errorf("timeout %s: effective timeout %s, want %s", tt.timeout, act.EffectiveTimeout, tt.want)
+		}
+	}
+}
+
+func TestAlerterBackendReply(t *testing.T) {
+	r := &stubRunner{stdout: "looks good\n", stderr: "2015-12-22 alerter[42] warning\n"}
+	act := deliverStub(t, r, &Options{Message: "Review ?", Reply: true, ReplyPlaceHolder: "Comment"})
+
+	args := strings.Join(r.started[0], " ")
+	if !strings.Contains(args, "-reply Comment") {
+		t.Errorf("command %q without -reply Comment", args)
+	}
+	if act.Type != ActivationTypeReplied || act.Value != "looks good" || act.Err != nil {
+		t.Errorf("activation %q %q %v, want the reply despite stderr", act.Type, act.Value, act.Err)
+	}
+}
+
+func TestAlerterBackendClose(t *testing.T) {
+	r := &stubRunner{interruptible: true}
+	b := &AlerterBackend{Path: "/opt/alerter", Runner: r}
+	n, err := b.Deliver(&Options{Message: "hello"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := n.Close(); err != nil {
+		t.Fatal(err)
+	}
+	act := <-n.Activations()
+	if act.Type != ActivationTypeClosed || act.Err != nil {
+		t.Errorf("activation %q %v, want closed", act.Type, act.Err)
+	}
+	if len(r.signals) != 1 || r.signals[0] != syscall.SIGINT {
+		t.Errorf("signals %v, want SIGINT", r.signals)
+	}
+}
+
+func TestAlerterBackendRemove(t *testing.T) {
+	r := &stubRunner{}
+	b := &AlerterBackend{Path: "/opt/alerter", Runner: r}
+	if err := b.Remove("ci"); err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{{"/opt/alerter", "-remove", "ci"}}
+	if !reflect.DeepEqual(r.started, want) {
+		t.Errorf("started %q, want %q", r.started, want)
+	}
+}
+
+func TestAlerterBackendFailures(t *testing.T) {
+	tests := []struct {
+		name         string
+		runner       *stubRunner
+		wantErr      error
+		wantCause    error
+		wantCode     int
+		wantStderr   string
+		wantOutput   string
+		wantInString string
+	}{
+		{
+			name:         "crash",
+			runner:       &stubRunner{stderr: "dyld: Library not loaded\n", waitErr: stubExit(134)},
+			wantErr:      ErrBackendCrashed,
+			wantCode:     134,
+			wantStderr:   "dyld: Library not loaded\n",
+			wantInString: "(exit code 134)",
+		},
+		{
+			name:     "crash after output",
+			runner:   &stubRunner{stdout: "@CLOSED\n", waitErr: stubExit(1)},
+			wantErr:  ErrBackendCrashed,
+			wantCode: 1,
+			// the output is kept for diagnosis
+			wantOutput: "@CLOSED\n",
+		},
+		{
+			name:         "read error",
+			runner:       &stubRunner{stdout: `{"activationType":`, readErr: io.ErrClosedPipe},
+			wantErr:      ErrBackendCrashed,
+			wantOutput:   `{"activationType":`,
+			wantInString: io.ErrClosedPipe.Error(),
+		},
+		{
+			name:         "garbage",
+			runner:       &stubRunner{stdout: "garbage\n"},
+			wantErr:      ErrBadActivationPayload,
+			wantOutput:   "garbage\n",
+			wantInString: `unexpected output "garbage"`,
+		},
+		{
+			name:      "empty output",
+			runner:    &stubRunner{},
+			wantErr:   ErrBadActivationPayload,
+			wantCause: ErrEmptyActivation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			act := deliverStub(t, tt.runner, &Options{Message: "hello", Actions: []string{"Yes"}})
+			if act.Type != ActivationTypeFailed {
+				t.Fatalf("activation %q, want failed", act.Type)
+			}
+			var backendErr *BackendError
+			if !errors.As(act.Err, &backendErr) {
+				t.Fatalf("error %v is not a *BackendError", act.Err)
+			}
+			if !errors.Is(act.Err, tt.wantErr) {
+				t.Errorf("error %v, want %v", act.Err, tt.wantErr)
+			}
+			if tt.wantCause != nil && !errors.Is(act.Err, tt.wantCause) {
+				t.Errorf("error %v, want the cause %v", act.Err, tt.wantCause)
+			}
+			for _, other := range []error{ErrBackendCrashed, ErrBadActivationPayload} {
+				if other != tt.wantErr && errors.Is(act.Err, other) {
+					t.Errorf("error %v also matches %v", act.Err, other)
+				}
+			}
+			if backendErr.ExitCode != tt.wantCode {
+				t.Errorf("exit code %d, want %d", backendErr.ExitCode, tt.wantCode)
+			}
+			if backendErr.Stderr != tt.wantStderr {
+				t.Errorf("stderr %q, want %q", backendErr.Stderr, tt.wantStderr)
+			}
+			if string(backendErr.Output) != tt.wantOutput {
+				t.Errorf("output %q, want %q", backendErr.Output, tt.wantOutput)
+			}
+			if !strings.Contains(act.Err.Error(), tt.wantInString) {
+				t.Errorf("message %q does not hold %q", act.Err, tt.wantInString)
+			}
+			if strings.Count(act.Err.Error(), tt.wantErr.Error()) != 1 {
+				t.Errorf("message %q does not hold %q once", act.Err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAlerterBackendStartError(t *testing.T) {
+	r := &stubRunner{startErr: os.ErrNotExist}
+	b := &AlerterBackend{Path: "/opt/alerter", Runner: r}
+	if _, err := b.Deliver(&Options{Message: "hello"}); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("error = %v, want os.ErrNotExist", err)
+	}
+}
+
+func TestAlerterBackendRemoveFailure(t *testing.T) {
+	r := &stubRunner{stderr: "no such group\n", waitErr: stubExit(1)}
+	b := &AlerterBackend{Path: "/opt/alerter", Runner: r}
+	err := b.Remove("ci")
+	var backendErr *BackendError
+	if !errors.As(err, &backendErr) || !errors.Is(err, ErrBackendCrashed) {
+		t.Fatalf("error = %v, want a crashed *BackendError", err)
+	}
+	if backendErr.ExitCode != 1 || backendErr.Stderr != "no such group\n" {
+		t.Errorf("exit code %d, stderr %q", backendErr.ExitCode, backendErr.Stderr)
+	}
+}
+
+func TestBackendErrorMessage(t *testing.T) {
+	act := deliverStub(t, &stubRunner{stdout: "garbage\n"}, &Options{Message: "hello", Actions: []string{"Yes"}})
+	want := `bad activation payload: unexpected output "garbage"`
+	if act.Err == nil || act.Err.Error() != want {
+		t.Errorf("message %q, want %q", act.Err, want)
+	}
+
+	err := &BackendError{Err: ErrBackendCrashed, ExitCode: 2, Stderr: "boom\n", Cause: stubExit(2)}
+	want = "notification backend crashed (exit code 2): exit status 2: boom"
+	if err.Error() != want {
+		t.Errorf("message %q, want %q", err, want)
+	}
+}
@@ -0,0 +1,298 @@
+// Package alertmanager displays the alerts of a Prometheus Alertmanager as
+// gosxalerter alerts, by receiving its webhook notifications.
+//
+//	receiver := alertmanager.NewReceiver(nil, &alertmanager.APISilencer{URL: "http://alertmanager:9093"})
+//	http.Handle("/alertmanager", receiver)
+//
+// with the Alertmanager configuration
+//
+//	receivers:
+//	- name: desktop
+//	  webhook_configs:
+//	  - url: http://localhost:8080/alertmanager
+//
+// Each firing alert is displayed once, deduplicated by fingerprint, with
+// an "Ack" action and a "Silence 1h" action silencing it through the
+// Silencer. Resolved alerts are closed.
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+)
+
+// AckAction is the label of the action acknowledging an alert.
+const AckAction = "Ack"
+
+// Defaults of the Receiver settings.
+const (
+	DefaultSilenceDuration = time.Hour
+	DefaultSilenceTimeout  = 30 * time.Second
+)
+
+// maxBodySize limits the size of the webhook notifications.
+const maxBodySize = 4 << 20
+
+// DefaultSounds are the sounds played per severity label.
+var DefaultSounds = map[string]gosxalerter.Sound{
+	"critical": gosxalerter.SoundBasso,
+	"warning":  gosxalerter.SoundDefault,
+}
+
+// Message is a webhook notification sent by Alertmanager.
+type Message struct {
+	Version           string            `json:"version"`
+	GroupKey          string            `json:"groupKey"`
+	TruncatedAlerts   int               `json:"truncatedAlerts"`
+	Status            string            `json:"status"`
+	Receiver          string            `json:"receiver"`
+	GroupLabels       map[string]string `json:"groupLabels"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	ExternalURL       string            `json:"externalURL"`
+	Alerts            []Alert           `json:"alerts"`
+}
+
+// Alert is an alert of a webhook notification.
+type Alert struct {
+	Status       string            `json:"status"` // "firing" or "resolved"
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint"`
+}
+
+// Silencer silences alerts, as asked by the "Silence" action.
+type Silencer interface {
+	Silence(ctx context.Context, alert Alert, d time.Duration) error
+}
+
+// SilencerFunc is a function used as a Silencer.
+type SilencerFunc func(ctx context.Context, alert Alert, d time.Duration) error
+
+// Silence calls f(ctx, alert, d).
+func (f SilencerFunc) Silence(ctx context.Context, alert Alert, d time.Duration) error {
+	return f(ctx, alert, d)
+}
+
+// Receiver is an http.Handler receiving the webhook notifications of
+// Alertmanager and delivering their firing alerts through its backend.
+type Receiver struct {
+	Backend         gosxalerter.Backend          // Backend delivering the alerts, gosxalerter.DefaultBackend when nil
+	Silencer        Silencer                     // Silences alerts, no "Silence" action when nil
+	SilenceDuration time.Duration                // Duration of the silences, DefaultSilenceDuration when 0
+	Sounds          map[string]gosxalerter.Sound // Sounds per severity label, DefaultSounds when nil
+	Options         []gosxalerter.Option         // Options of the alerts, applied over the ones set from the labels
+	ErrorLog        *log.Logger                  // Logs failing deliveries and silences, the standard logger when nil
+
+	manager *gosxalerter.Manager
+
+	mu       sync.Mutex
+	notified map[string]*notified
+}
+
+// notified is an alert notified to the user, by fingerprint. Acknowledged
+// and silenced alerts are kept until resolved, so they are not notified
+// again.
+type notified struct {
+	alert *gosxalerter.Alert
+}
+
+// NewReceiver returns a Receiver delivering alerts through backend,
+// gosxalerter.DefaultBackend when nil, and silencing them with silencer.
+func NewReceiver(backend gosxalerter.Backend, silencer Silencer) *Receiver {
+	return &Receiver{
+		Backend:  backend,
+		Silencer: silencer,
+		manager:  gosxalerter.NewManager(0),
+		notified: make(map[string]*notified),
+	}
+}
+
+// ServeHTTP handles a webhook notification.
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	var msg Message
+	if err := json.NewDecoder(http.MaxBytesReader(w, req.Body, maxBodySize)).Decode(&msg); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.Receive(&msg)
+	w.WriteHeader(http.StatusOK)
+}
+
+// Receive displays the firing alerts of msg which are not displayed yet,
+// and closes its resolved alerts.
+func (r *Receiver) Receive(msg *Message) {
+	for _, alert := range msg.Alerts {
+		if alert.Status == "resolved" {
+			r.resolve(alert)
+		} else {
+			r.fire(alert)
+		}
+	}
+}
+
+// Shutdown stops delivering alerts and waits for the displayed ones to be
+// activated, as gosxalerter.Manager.Shutdown does.
+func (r *Receiver) Shutdown(ctx context.Context) error {
+	return r.manager.Shutdown(ctx)
+}
+
+func (r *Receiver) fire(alert Alert) {
+	r.mu.Lock()
+	if _, ok := r.notified[alert.Fingerprint]; ok {
+		r.mu.Unlock()
+		return
+	}
+	n := &notified{}
+	r.notified[alert.Fingerprint] = n
+	r.mu.Unlock()
+
+	forget := func() {
+		r.mu.Lock()
+		if r.notified[alert.Fingerprint] == n {
+			delete(r.notified, alert.Fingerprint)
+		}
+		r.mu.Unlock()
+	}
+
+	a, err := gosxalerter.New(message(alert), append(r.alertOptions(alert), r.Options...)...)
+	if err != nil {
+		r.logf("alert %s: %v", alert.Fingerprint, err)
+		forget()
+		return
+	}
+	activationChan, err := r.manager.Deliver(context.Background(), a)
+	if err != nil {
+		r.logf("alert %s: %v", alert.Fingerprint, err)
+		forget()
+		return
+	}
+	r.mu.Lock()
+	n.alert = a
+	resolved := r.notified[alert.Fingerprint] != n
+	r.mu.Unlock()
+	if resolved {
+		a.Close()
+	}
+
+	go func() {
+		activation := <-activationChan
+		switch {
+		case activation.IsAction(AckAction):
+			// kept until resolved
+		case r.Silencer != nil && activation.IsAction(r.silenceAction()):
+			ctx, cancel := context.WithTimeout(context.Background(), DefaultSilenceTimeout)
+			defer cancel()
+			if err := r.Silencer.Silence(ctx, alert, r.silenceDuration()); err != nil {
+				r.logf("alert %s: silence: %v", alert.Fingerprint, err)
+				forget()
+			}
+		default:
+			// Dismissed, notify again when Alertmanager repeats it.
+			forget()
+		}
+	}()
+}
+
+func (r *Receiver) resolve(alert Alert) {
+	r.mu.Lock()
+	var displayed *gosxalerter.Alert
+	if n, ok := r.notified[alert.Fingerprint]; ok {
+		displayed = n.alert
+		delete(r.notified, alert.Fingerprint)
+	}
+	r.mu.Unlock()
+	if displayed != nil {
+		displayed.Close()
+	}
+}
+
+// alertOptions maps the labels and annotations of alert onto options.
+func (r *Receiver) alertOptions(alert Alert) []gosxalerter.Option {
+	name := alert.Labels["alertname"]
+	opts := []gosxalerter.Option{
+		gosxalerter.WithBackend(r.Backend),
+		gosxalerter.WithGroup(name),
+	}
+
+	title := name
+	if severity := alert.Labels["severity"]; severity != "" {
+		title += " [" + severity + "]"
+		sounds := r.Sounds
+		if sounds == nil {
+			sounds = DefaultSounds
+		}
+		if sound, ok := sounds[severity]; ok {
+			opts = append(opts, gosxalerter.WithSound(sound))
+		}
+	}
+	if title != "" {
+		opts = append(opts, gosxalerter.WithTitle(title))
+	}
+	if summary := alert.Annotations["summary"]; summary != "" && alert.Annotations["description"] != "" {
+		opts = append(opts, gosxalerter.WithSubtitle(summary))
+	}
+
+	actions := []string{AckAction}
+	if r.Silencer != nil {
+		actions = append(actions, r.silenceAction())
+	}
+	return append(opts, gosxalerter.WithActions(actions...))
+}
+
+// message returns the description of alert, or its summary, or its name.
+func message(alert Alert) string {
+	for _, text := range []string{
+		alert.Annotations["description"],
+		alert.Annotations["summary"],
+		alert.Labels["alertname"],
+	} {
+		if text != "" {
+			return text
+		}
+	}
+	return "Alert " + alert.Fingerprint
+}
+
+func (r *Receiver) silenceDuration() time.Duration {
+	if r.SilenceDuration == 0 {
+		return DefaultSilenceDuration
+	}
+	return r.SilenceDuration
+}
+
+// silenceAction returns the label of the silence action, such as
+// "Silence 1h".
+func (r *Receiver) silenceAction() string {
+	d := r.silenceDuration().String()
+	if strings.HasSuffix(d, "m0s") {
+		d = strings.TrimSuffix(d, "0s")
+	}
+	if strings.HasSuffix(d, "h0m") {
+		d = strings.TrimSuffix(d, "0m")
+	}
+	return "Silence " + d
+}
+
+func (r *Receiver) logf(format string, args ...interface{}) {
+	if r.ErrorLog != nil {
+		r.ErrorLog.Printf(format, args...)
+	} else {
+		log.Printf(format, args...)
+	}
+}
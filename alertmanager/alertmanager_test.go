@@ -0,0 +1,173 @@
+package alertmanager_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+	"github.com/vjeantet/gosx-alerter/alertmanager"
+	"github.com/vjeantet/gosx-alerter/gosxalertertest"
+)
+
+// notify posts a webhook notification of a single alert to r.
+func notify(t *testing.T, r http.Handler, status, fingerprint string) {
+	t.Helper()
+	msg := alertmanager.Message{
+		Version: "4",
+		Status:  status,
+		Alerts: []alertmanager.Alert{{
+			Status:      status,
+			Fingerprint: fingerprint,
+			Labels:      map[string]string{"alertname": "HighLoad", "severity": "critical", "instance": "db1"},
+			Annotations: map[string]string{"summary": "High load", "description": "Load is 12 on db1"},
+		}},
+	}
+	body, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/alertmanager", strings.NewReader(string(body))))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("webhook answered %d: %s", rec.Code, rec.Body)
+	}
+}
+
+// eventually waits for cond, failing the test after a second.
+func eventually(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestReceiverDedupeAndResolve(t *testing.T) {
+	backend := gosxalertertest.New()
+	r := alertmanager.NewReceiver(backend, nil)
+
+	notify(t, r, "firing", "f1")
+	notify(t, r, "firing", "f1")
+	delivered := backend.Delivered()
+	if len(delivered) != 1 {
+		t.Fatalf("delivered %d alerts, want 1", len(delivered))
+	}
+	opts := delivered[0]
+	if opts.Title != "HighLoad [critical]" || opts.Subtitle != "High load" || opts.Message != "Load is 12 on db1" {
+		t.Errorf("texts %q %q %q", opts.Title, opts.Subtitle, opts.Message)
+	}
+	if opts.Sound != gosxalerter.SoundBasso || opts.Group != "HighLoad" {
+		t.Errorf("sound %q, group %q, want Basso and HighLoad", opts.Sound, opts.Group)
+	}
+	if got := strings.Join(opts.Actions, ","); got != alertmanager.AckAction {
+		t.Errorf("actions %q, want only Ack without silencer", got)
+	}
+
+	notify(t, r, "resolved", "f1")
+	eventually(t, "the resolved alert to be closed", func() bool { return backend.Displayed() == 0 })
+
+	notify(t, r, "firing", "f1")
+	if n := len(backend.Delivered()); n != 2 {
+		t.Errorf("delivered %d alerts after firing again, want 2", n)
+	}
+}
+
+func TestReceiverAck(t *testing.T) {
+	backend := gosxalertertest.New()
+	backend.ClickAction(0)
+	r := alertmanager.NewReceiver(backend, nil)
+
+	notify(t, r, "firing", "f1")
+	eventually(t, "the ack", func() bool { return backend.Displayed() == 0 })
+	notify(t, r, "firing", "f1")
+	if n := len(backend.Delivered()); n != 1 {
+		t.Errorf("delivered %d alerts, want the acknowledged alert not notified again", n)
+	}
+
+	notify(t, r, "resolved", "f1")
+	notify(t, r, "firing", "f1")
+	if n := len(backend.Delivered()); n != 2 {
+		t.Errorf("delivered %d alerts after resolution, want 2", n)
+	}
+}
+
+func TestReceiverDismiss(t *testing.T) {
+	backend := gosxalertertest.New()
+	backend.Dismiss()
+	r := alertmanager.NewReceiver(backend, nil)
+
+	notify(t, r, "firing", "f1")
+	eventually(t, "the alert to be notified again", func() bool {
+		notify(t, r, "firing", "f1")
+		return len(backend.Delivered()) == 2
+	})
+}
+
+func TestReceiverSilence(t *testing.T) {
+	silences := make(chan map[string]interface{}, 1)
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || req.URL.Path != "/api/v2/silences" {
+			http.NotFound(w, req)
+			return
+		}
+		var body map[string]interface{}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		silences <- body
+		w.Write([]byte(`{"silenceID":"s1"}`))
+	}))
+	defer stub.Close()
+
+	backend := gosxalertertest.New()
+	backend.ClickAction(1)
+	r := alertmanager.NewReceiver(backend, &alertmanager.APISilencer{URL: stub.URL, CreatedBy: "tester"})
+
+	notify(t, r, "firing", "f1")
+	if got := strings.Join(backend.Delivered()[0].Actions, ","); got != "Ack,Silence 1h" {
+		t.Errorf("actions %q, want Ack,Silence 1h", got)
+	}
+
+	var body map[string]interface{}
+	select {
+	case body = <-silences:
+	case <-time.After(time.Second):
+		t.Fatal("no silence posted")
+	}
+	matchers, _ := json.Marshal(body["matchers"])
+	want := `[{"isEqual":true,"isRegex":false,"name":"alertname","value":"HighLoad"},` +
+		`{"isEqual":true,"isRegex":false,"name":"instance","value":"db1"},` +
+		`{"isEqual":true,"isRegex":false,"name":"severity","value":"critical"}]`
+	if string(matchers) != want {
+		t.Errorf("matchers %s, want %s", matchers, want)
+	}
+	if body["createdBy"] != "tester" {
+		t.Errorf("createdBy %v, want tester", body["createdBy"])
+	}
+	startsAt, _ := time.Parse(time.RFC3339, body["startsAt"].(string))
+	endsAt, _ := time.Parse(time.RFC3339, body["endsAt"].(string))
+	if d := endsAt.Sub(startsAt); d < time.Hour-time.Second || d > time.Hour+time.Second {
+		t.Errorf("silence of %s, want 1h", d)
+	}
+
+	notify(t, r, "firing", "f1")
+	if n := len(backend.Delivered()); n != 1 {
+		t.Errorf("delivered %d alerts, want the silenced alert not notified again", n)
+	}
+}
+
+func TestReceiverMethod(t *testing.T) {
+	rec := httptest.NewRecorder()
+	alertmanager.NewReceiver(gosxalertertest.New(), nil).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET answered %d, want 405", rec.Code)
+	}
+}
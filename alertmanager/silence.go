@@ -0,0 +1,89 @@
+package alertmanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// APISilencer silences alerts by creating silences through the API v2 of
+// Alertmanager, matching every label of the alert.
+type APISilencer struct {
+	URL       string       // Base URL of Alertmanager, such as "http://alertmanager:9093"
+	CreatedBy string       // Author of the silences, defaults to the user name
+	Client    *http.Client // HTTP client, http.DefaultClient when nil
+}
+
+type matcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"isRegex"`
+	IsEqual bool   `json:"isEqual"`
+}
+
+type silence struct {
+	Matchers  []matcher `json:"matchers"`
+	StartsAt  time.Time `json:"startsAt"`
+	EndsAt    time.Time `json:"endsAt"`
+	CreatedBy string    `json:"createdBy"`
+	Comment   string    `json:"comment"`
+}
+
+// Silence creates a silence of alert lasting d.
+func (s *APISilencer) Silence(ctx context.Context, alert Alert, d time.Duration) error {
+	createdBy := s.CreatedBy
+	if createdBy == "" {
+		createdBy = os.Getenv("USER")
+	}
+	if createdBy == "" {
+		createdBy = filepath.Base(os.Args[0])
+	}
+
+	names := make([]string, 0, len(alert.Labels))
+	for name := range alert.Labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	body := silence{
+		StartsAt:  time.Now().UTC(),
+		EndsAt:    time.Now().UTC().Add(d),
+		CreatedBy: createdBy,
+		Comment:   "Silenced from a desktop alert",
+	}
+	for _, name := range names {
+		body.Matchers = append(body.Matchers, matcher{Name: name, Value: alert.Labels[name], IsEqual: true})
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(s.URL, "/")+"/api/v2/silences", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("alertmanager: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
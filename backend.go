@@ -0,0 +1,79 @@
+package gosxalerter
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoBackend is returned when an alert is delivered while neither the
+// alert nor the package has a Backend configured.
+var ErrNoBackend = errors.New("no notification backend available")
+
+// DefaultBackend is used by alerts which do not set their own Backend.
+// It defaults to the embedded alerter binary on OSX and is nil elsewhere.
+var DefaultBackend Backend
+
+// Backend delivers alerts to a notification system.
+type Backend interface {
+	// Deliver displays a notification built from opts.
+	Deliver(opts *Options) (Notification, error)
+	// Remove removes every notification delivered with the given group ID.
+	Remove(group string) error
+}
+
+// Limits are the lengths, in graphemes, a notification system displays
+// before clipping a text, 0 for no limit.
+type Limits struct {
+	Title    int
+	Subtitle int
+	Message  int
+}
+
+// LimitedBackend is implemented by backends whose notification system
+// clips long texts, alerts are truncated to Limits with an ellipsis
+// before delivery.
+type LimitedBackend interface {
+	Backend
+	Limits() Limits
+}
+
+// ValidatingBackend is implemented by backends accepting a part of the
+// valid options only, such as the sounds of their notification system.
+// Validate is called after Options.Validate, and returns its problems as
+// *FieldError too.
+type ValidatingBackend interface {
+	Backend
+	Validate(opts *Options) error
+}
+
+// ContextBackend is implemented by backends whose delivery may block,
+// such as on the network. Alert.DeliverContext then calls DeliverContext,
+// so ctx bounds the delivery too.
+type ContextBackend interface {
+	Backend
+	DeliverContext(ctx context.Context, opts *Options) (Notification, error)
+}
+
+// RemoteBackend is implemented by backends displaying alerts on another
+// machine, such as through a gateway. The images of their alerts given as
+// local paths are not checked, they name files of that machine.
+type RemoteBackend interface {
+	Backend
+	Remote() bool
+}
+
+// Previewer is implemented by backends able to tell what they would send
+// to the notification system for an alert, without delivering it.
+type Previewer interface {
+	Backend
+	Preview(opts *Options) (interface{}, error)
+}
+
+// Notification is a notification displayed by a Backend.
+type Notification interface {
+	// Activations returns a chan that receives a single Activation when
+	// the user or the OS interacts with the notification, then is closed.
+	Activations() <-chan *Activation
+	// Close dismisses the notification.
+	Close() error
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+	"github.com/vjeantet/gosx-alerter/freedesktop"
+	"github.com/vjeantet/gosx-alerter/remote"
+)
+
+// backendFlags select the backend delivering alerts.
+type backendFlags struct {
+	name    string
+	alerter string
+	gateway string
+	token   string
+}
+
+func (f *backendFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.name, "backend", "auto", "notification backend: auto, alerter, freedesktop or remote")
+	fs.StringVar(&f.alerter, "alerter", "", "alerter executable, instead of the embedded one")
+	fs.StringVar(&f.gateway, "gateway", "", "URL of the gateway used by the remote backend")
+	fs.StringVar(&f.token, "token", os.Getenv("GOSX_ALERTER_TOKEN"), "bearer token of the gateway, defaults to $GOSX_ALERTER_TOKEN")
+}
+
+// backend returns the selected backend, auto picks alerter on OSX and
+// freedesktop elsewhere.
+func (f *backendFlags) backend() (gosxalerter.Backend, error) {
+	name := f.name
+	if name == "auto" {
+		name = "freedesktop"
+		if runtime.GOOS == "darwin" {
+			name = "alerter"
+		}
+	}
+
+	switch name {
+	case "alerter":
+		return &gosxalerter.AlerterBackend{Path: f.alerter}, nil
+	case "freedesktop":
+		return freedesktop.New()
+	case "remote":
+		if f.gateway == "" {
+			return nil, errors.New("the remote backend needs -gateway")
+		}
+		return remote.New(f.gateway, f.token), nil
+	}
+	return nil, fmt.Errorf("unknown backend %q", f.name)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"flag"
+	"strings"
+	"time"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+)
+
+// alertFlags are the flags setting the Options of an alert.
+type alertFlags struct {
+	title            string
+	subtitle         string
+	sound            string
+	sender           string
+	group            string
+	appIcon          string
+	contentImage     string
+	actions          stringList
+	actionList       string
+	reply            bool
+	replyPlaceholder string
+	closeLabel       string
+	dropdownLabel    string
+	timeout          time.Duration
+}
+
+func (f *alertFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.title, "title", "", "title of the notification")
+	fs.StringVar(&f.subtitle, "subtitle", "", "text under the title")
+	fs.StringVar(&f.sound, "sound", "", "sound played when the alert pops up, 'default' or a sound name")
+	fs.StringVar(&f.sender, "sender", "", "send the notification as a known app, by bundle identifier")
+	fs.StringVar(&f.group, "group", "", "group ID, replaces the displayed alert of the same group")
+	fs.StringVar(&f.appIcon, "appIcon", "", "path or URL of the app icon")
+	fs.StringVar(&f.contentImage, "contentImage", "", "path or URL of an attached image")
+	fs.Var(&f.actions, "action", "action available on the alert, repeat for more actions")
+	fs.StringVar(&f.actionList, "actions", "", "comma separated actions, as alerter takes them")
+	fs.BoolVar(&f.reply, "reply", false, "ask the user for a reply")
+	fs.StringVar(&f.replyPlaceholder, "replyPlaceholder", "", "placeholder of the reply field")
+	fs.StringVar(&f.closeLabel, "closeLabel", "", "label of the close button")
+	fs.StringVar(&f.dropdownLabel, "dropdownLabel", "", "label of the actions dropdown")
+	fs.DurationVar(&f.timeout, "timeout", 0, "close the alert after this duration")
+}
+
+// options returns the gosxalerter options set by the flags.
+func (f *alertFlags) options() []gosxalerter.Option {
+	var opts []gosxalerter.Option
+	set := func(value string, opt func(string) gosxalerter.Option) {
+		if value != "" {
+			opts = append(opts, opt(value))
+		}
+	}
+	set(f.title, gosxalerter.WithTitle)
+	set(f.subtitle, gosxalerter.WithSubtitle)
+	set(f.sender, gosxalerter.WithSender)
+	set(f.group, gosxalerter.WithGroup)
+	set(f.appIcon, gosxalerter.WithAppIcon)
+	set(f.contentImage, gosxalerter.WithContentImage)
+	set(f.closeLabel, gosxalerter.WithCloseLabel)
+	set(f.dropdownLabel, gosxalerter.WithDropdownLabel)
+
+	switch f.sound {
+	case "":
+	case "default":
+		opts = append(opts, gosxalerter.WithSound(gosxalerter.SoundDefault))
+	default:
+		opts = append(opts, gosxalerter.WithSound(gosxalerter.Sound(f.sound)))
+	}
+
+	actions := append([]string(nil), f.actions...)
+	if f.actionList != "" {
+		actions = append(actions, strings.Split(f.actionList, ",")...)
+	}
+	if len(actions) > 0 {
+		opts = append(opts, gosxalerter.WithActions(actions...))
+	}
+	if f.reply {
+		opts = append(opts, gosxalerter.WithReply(f.replyPlaceholder))
+	}
+	if f.timeout != 0 {
+		opts = append(opts, gosxalerter.WithTimeout(f.timeout))
+	}
+	return opts
+}
+
+// stringList is a flag.Value collecting repeated flags.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
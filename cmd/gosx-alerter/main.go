@@ -0,0 +1,177 @@
+// Command gosx-alerter delivers a desktop alert and prints how the user
+// answered it, so that shell scripts can prompt users.
+//
+//	gosx-alerter -title Deploy -action Now -action Later "Deploy on UAT ?"
+//
+// The answer is printed as plain text, the clicked action or the reply,
+// or as JSON with -format json. The exit code tells the kind of answer:
+//
+//	0  an action or the alert was clicked, or the user replied
+//	1  the alert was closed
+//	2  the alert timed out
+//	3  gosx-alerter was interrupted
+//	4  the notification backend failed
+//	64 invalid command line
+//
+// The pipe subcommand reads lines from stdin and raises an alert for each
+// matching line, see "gosx-alerter pipe -h".
+//
+//	make 2>&1 | gosx-alerter pipe -match 'error:' -coalesce 5s -noun errors
+//
+// The watch subcommand runs a command, then alerts with its exit status,
+// its duration and the last lines of its output. It exits with the exit
+// code of the command.
+//
+//	gosx-alerter watch -sound default make release
+//
+// The serve subcommand serves the HTTP gateway of package gateway, so that
+// remote programs raise alerts on this desktop.
+//
+//	gosx-alerter serve -addr localhost:8080
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+)
+
+// Exit codes, per activation type.
+const (
+	exitActivated = 0
+	exitClosed    = 1
+	exitTimeout   = 2
+	exitCanceled  = 3
+	exitFailed    = 4
+	exitUsage     = 64
+)
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	if len(args) > 0 {
+		switch args[0] {
+		case "pipe":
+			return runPipe(args[1:])
+		case "watch":
+			return runWatch(args[1:])
+		case "serve":
+			return runServe(args[1:])
+		}
+	}
+	return runAlert(args)
+}
+
+// runAlert delivers a single alert and prints its activation.
+func runAlert(args []string) int {
+	fs := flag.NewFlagSet("gosx-alerter", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gosx-alerter [flags] message")
+		fs.PrintDefaults()
+	}
+
+	var (
+		alertFlags   alertFlags
+		backendFlags backendFlags
+		message      = fs.String("message", "", "message of the alert, or the arguments")
+		format       = fs.String("format", "text", "output format: text or json")
+		preview      = fs.Bool("preview", false, "print what would be sent to the notification system, without delivering")
+	)
+	alertFlags.register(fs)
+	backendFlags.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if *message == "" {
+		*message = strings.Join(fs.Args(), " ")
+	}
+	if *format != "text" && *format != "json" {
+		fmt.Fprintf(os.Stderr, "gosx-alerter: unknown format %q\n", *format)
+		return exitUsage
+	}
+
+	backend, err := backendFlags.backend()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gosx-alerter:", err)
+		return exitFailed
+	}
+
+	alert, err := gosxalerter.New(*message, append(alertFlags.options(), gosxalerter.WithBackend(backend))...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gosx-alerter:", err)
+		return exitUsage
+	}
+
+	if *preview {
+		payload, err := alert.Preview()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "gosx-alerter:", err)
+			return exitFailed
+		}
+		printJSON(payload)
+		return exitActivated
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	activation, err := alert.DeliverAndWaitContext(ctx)
+	if activation == nil {
+		fmt.Fprintln(os.Stderr, "gosx-alerter:", err)
+		return exitFailed
+	}
+	if err != nil && !errors.Is(err, context.Canceled) {
+		fmt.Fprintln(os.Stderr, "gosx-alerter:", err)
+	}
+
+	printActivation(activation, *format)
+	return exitCode(activation)
+}
+
+// printActivation prints the activation as JSON, or as alerter prints it
+// without -json: the value, or the activation type such as @CLOSED.
+func printActivation(activation *gosxalerter.Activation, format string) {
+	if format == "json" {
+		printJSON(activation)
+		return
+	}
+	switch {
+	case activation.Value != "":
+		fmt.Println(activation.Value)
+	case activation.Type == gosxalerter.ActivationTypeContentsClicked:
+		fmt.Println("@CONTENTCLICKED")
+	default:
+		fmt.Println("@" + strings.ToUpper(string(activation.Type)))
+	}
+}
+
+func printJSON(v interface{}) {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	enc.Encode(v)
+}
+
+// exitCode returns the exit code telling the kind of activation.
+func exitCode(activation *gosxalerter.Activation) int {
+	switch activation.Type {
+	case gosxalerter.ActivationTypeClosed:
+		return exitClosed
+	case gosxalerter.ActivationTypeTimeOut:
+		return exitTimeout
+	case gosxalerter.ActivationTypeCanceled:
+		return exitCanceled
+	case gosxalerter.ActivationTypeFailed:
+		return exitFailed
+	}
+	return exitActivated
+}
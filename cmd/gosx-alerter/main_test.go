@@ -0,0 +1,88 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"reflect"
+	"testing"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+	"github.com/vjeantet/gosx-alerter/gosxalertertest"
+)
+
+func TestExitCode(t *testing.T) {
+	for activationType, want := range map[gosxalerter.ActivationType]int{
+		gosxalerter.ActivationTypeActionClicked:   0,
+		gosxalerter.ActivationTypeContentsClicked: 0,
+		gosxalerter.ActivationTypeReplied:         0,
+		gosxalerter.ActivationTypeClosed:          1,
+		gosxalerter.ActivationTypeTimeOut:         2,
+		gosxalerter.ActivationTypeCanceled:        3,
+		gosxalerter.ActivationTypeFailed:          4,
+	} {
+		if got := exitCode(&gosxalerter.Activation{Type: activationType}); got != want {
+			t.Errorf("exit code of %s: %d, want %d", activationType, got, want)
+		}
+	}
+}
+
+// parseAlertFlags returns the options of an alert configured by args.
+func parseAlertFlags(t *testing.T, args ...string) *gosxalerter.Options {
+	t.Helper()
+	var f alertFlags
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	f.register(fs)
+	if err := fs.Parse(args); err != nil {
+		t.Fatal(err)
+	}
+	a, err := gosxalerter.New("hello", append(f.options(), gosxalerter.WithBackend(gosxalertertest.New()))...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a.Options
+}
+
+func TestAlertFlagsActions(t *testing.T) {
+	opts := parseAlertFlags(t, "-action", "Now", "-actions", "Later,Never", "-action", "Tomorrow")
+	want := []string{"Now", "Tomorrow", "Later", "Never"}
+	if !reflect.DeepEqual(opts.Actions, want) {
+		t.Errorf("actions %q, want %q", opts.Actions, want)
+	}
+}
+
+func TestAlertFlagsSound(t *testing.T) {
+	for value, want := range map[string]gosxalerter.Sound{
+		"":          "",
+		"default":   gosxalerter.SoundDefault,
+		"Submarine": gosxalerter.SoundSubmarine,
+	} {
+		if got := parseAlertFlags(t, "-sound", value).Sound; got != want {
+			t.Errorf("-sound %q: sound %q, want %q", value, got, want)
+		}
+	}
+}
+
+func TestRunUsage(t *testing.T) {
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer devNull.Close()
+	stderr := os.Stderr
+	os.Stderr = devNull
+	defer func() { os.Stderr = stderr }()
+
+	for _, args := range [][]string{
+		{"-unknown", "hello"},
+		{"-timeout", "soon", "hello"},
+		{"-format", "xml", "hello"},
+		{"pipe", "-unknown"},
+		{"watch", "-unknown", "true"},
+		{"watch"},
+		{"serve", "-unknown"},
+	} {
+		if code := run(args); code != exitUsage {
+			t.Errorf("run(%q) = %d, want %d", args, code, exitUsage)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"regexp"
+	"syscall"
+	"time"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+)
+
+// runPipe raises an alert for each line of stdin matched by -match, or
+// holding a JSON object with -json, then waits for the alerts to be
+// activated.
+func runPipe(args []string) int {
+	fs := flag.NewFlagSet("gosx-alerter pipe", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gosx-alerter pipe [flags] < input")
+		fs.PrintDefaults()
+	}
+
+	var (
+		alertFlags   alertFlags
+		backendFlags backendFlags
+		match        = fs.String("match", "", "regular expression selecting the lines raising an alert, every line when empty")
+		jsonLines    = fs.Bool("json", false, "lines are JSON objects holding the options of the alert")
+		message      = fs.String("message", "{{.Line}}", "message template, given .Line and the named groups of -match")
+		coalesce     = fs.Duration("coalesce", time.Second, "alert once for the lines of a group matched within this duration")
+		noun         = fs.String("noun", "alerts", "what coalesced lines are, as in '12 new errors'")
+		tee          = fs.Bool("tee", false, "copy stdin to stdout")
+	)
+	alertFlags.register(fs)
+	backendFlags.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	re, err := regexp.Compile(*match)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gosx-alerter:", err)
+		return exitUsage
+	}
+
+	backend, err := backendFlags.backend()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gosx-alerter:", err)
+		return exitFailed
+	}
+
+	// The template alert validates the flags once, before reading stdin.
+	tmpl, err := gosxalerter.New(*message, append(alertFlags.options(), gosxalerter.WithBackend(backend))...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gosx-alerter:", err)
+		return exitUsage
+	}
+
+	var matcher gosxalerter.Matcher
+	if *jsonLines {
+		tmpl.Options.Message = ""
+		matcher = gosxalerter.MatchJSON(tmpl.Options)
+	} else {
+		matcher = gosxalerter.MatchRegexp(re, tmpl.Options)
+	}
+
+	var input io.Reader = os.Stdin
+	if *tee {
+		input = io.TeeReader(os.Stdin, os.Stdout)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	manager := gosxalerter.NewManager(0)
+	alerts, errc := gosxalerter.FromReader(input, matcher)
+	coalesced := gosxalerter.Coalesce(alerts, *coalesce, gosxalerter.CountSummary(*noun))
+	code := exitActivated
+read:
+	for {
+		select {
+		case alert, ok := <-coalesced:
+			if !ok {
+				if err := <-errc; err != nil {
+					fmt.Fprintln(os.Stderr, "gosx-alerter:", err)
+					code = exitFailed
+				}
+				break read
+			}
+			alert.Backend = backend
+			if _, err := manager.Replace(ctx, alert); err != nil && ctx.Err() == nil {
+				fmt.Fprintln(os.Stderr, "gosx-alerter:", err)
+				code = exitFailed
+			}
+		case <-ctx.Done():
+			break read
+		}
+	}
+
+	if err := manager.Shutdown(ctx); errors.Is(err, context.Canceled) {
+		return exitCanceled
+	}
+	return code
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/vjeantet/gosx-alerter/gateway"
+)
+
+// runServe serves the HTTP gateway until interrupted.
+func runServe(args []string) int {
+	fs := flag.NewFlagSet("gosx-alerter serve", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gosx-alerter serve [flags]")
+		fs.PrintDefaults()
+	}
+
+	var (
+		backendFlags backendFlags
+		addr         = fs.String("addr", "localhost:8080", "address to listen on")
+	)
+	backendFlags.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	backend, err := backendFlags.backend()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gosx-alerter:", err)
+		return exitFailed
+	}
+
+	gw := gateway.New(backend)
+	gw.Token = backendFlags.token
+	server := &http.Server{Addr: *addr, Handler: gw}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+		gw.Shutdown(shutdownCtx)
+	}()
+
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		fmt.Fprintln(os.Stderr, "gosx-alerter:", err)
+		return exitFailed
+	}
+	<-closed
+	return exitCanceled
+}
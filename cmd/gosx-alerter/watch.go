@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+)
+
+// runWatch runs a command then alerts on its completion, and exits with
+// the exit code of the command.
+func runWatch(args []string) int {
+	fs := flag.NewFlagSet("gosx-alerter watch", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gosx-alerter watch [flags] command [args...]")
+		fs.PrintDefaults()
+	}
+
+	var (
+		alertFlags   alertFlags
+		backendFlags backendFlags
+		lines        = fs.Int("lines", 5, "number of last output lines shown in the alert")
+		logDir       = fs.String("logdir", "", "directory of the command logs, defaults to the temp directory")
+	)
+	alertFlags.register(fs)
+	backendFlags.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return exitUsage
+	}
+	if *lines < 0 {
+		fmt.Fprintln(os.Stderr, "gosx-alerter: -lines must not be negative")
+		return exitUsage
+	}
+
+	backend, err := backendFlags.backend()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gosx-alerter:", err)
+		return exitFailed
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	cmd := exec.Command(fs.Arg(0), fs.Args()[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	result, err := gosxalerter.Watch(ctx, cmd, gosxalerter.WatchOptions{
+		Lines:  *lines,
+		LogDir: *logDir,
+		Alert:  append(alertFlags.options(), gosxalerter.WithBackend(backend)),
+	})
+	switch {
+	case errors.Is(err, context.Canceled):
+		return exitCanceled
+	case err != nil:
+		fmt.Fprintln(os.Stderr, "gosx-alerter:", err)
+		if result == nil {
+			return exitFailed
+		}
+	}
+
+	if result.LogPath != "" {
+		fmt.Fprintln(os.Stderr, "gosx-alerter: log written to", result.LogPath)
+	}
+	if result.ExitCode < 0 {
+		return exitFailed
+	}
+	return result.ExitCode
+}
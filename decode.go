@@ -0,0 +1,178 @@
+package gosxalerter
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrEmptyActivation is returned when decoding an answer with no
+// activation in it.
+var ErrEmptyActivation = errors.New("empty activation")
+
+// Plain-text answers printed by alerter without -json.
+var plainActivationTypes = map[string]ActivationType{
+	"@CLOSED":         ActivationTypeClosed,
+	"@TIMEOUT":        ActivationTypeTimeOut,
+	"@CONTENTCLICKED": ActivationTypeContentsClicked,
+	"@ACTIONCLICKED":  ActivationTypeActionClicked,
+}
+
+// DecodeActivation decodes the answer of alerter, printed with or without
+// -json. opts, when not nil, resolves the actions and replies printed as
+// plain text by alerter without -json, other text lines then fail with
+// ErrBadActivationPayload.
+func DecodeActivation(data []byte, opts *Options) (*Activation, error) {
+	act, err := NewDecoder(bytes.NewReader(data), opts).Decode()
+	if err == io.EOF {
+		return nil, ErrEmptyActivation
+	}
+	return act, err
+}
+
+// Decoder reads activations printed by alerter from a stream, which may
+// be written in several parts.
+type Decoder struct {
+	r    *bufio.Reader
+	opts *Options
+}
+
+// NewDecoder returns a Decoder reading from r, opts resolves plain-text
+// actions and replies as for DecodeActivation.
+func NewDecoder(r io.Reader, opts *Options) *Decoder {
+	return &Decoder{r: bufio.NewReader(r), opts: opts}
+}
+
+// Decode reads the next activation, either a JSON object or a line of
+// text. It returns io.EOF when the stream ends before an activation and
+// io.ErrUnexpectedEOF when it ends within a JSON object.
+func (d *Decoder) Decode() (*Activation, error) {
+	for {
+		c, err := d.r.ReadByte()
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, err
+		}
+		if strings.IndexByte(" \t\r\n", c) >= 0 {
+			continue
+		}
+		d.r.UnreadByte()
+		if c == '{' {
+			return d.decodeJSON()
+		}
+		return d.decodeText()
+	}
+}
+
+// decodeJSON reads a whole JSON object, tracking braces outside strings.
+func (d *Decoder) decodeJSON() (*Activation, error) {
+	var buf bytes.Buffer
+	depth, inString, escaped := 0, false, false
+	for {
+		c, err := d.r.ReadByte()
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteByte(c)
+
+		switch {
+		case escaped:
+			escaped = false
+		case inString && c == '\\':
+			escaped = true
+		case c == '"':
+			inString = !inString
+		case inString:
+		case c == '{':
+			depth++
+		case c == '}':
+			depth--
+		}
+		if depth == 0 {
+			break
+		}
+	}
+
+	act := &Activation{}
+	if err := act.UnmarshalJSON(buf.Bytes()); err != nil {
+		return nil, err
+	}
+	if act.Type == "" {
+		return nil, ErrEmptyActivation
+	}
+	d.resolveAction(act)
+	return act, nil
+}
+
+// decodeText reads a line printed by alerter without -json.
+func (d *Decoder) decodeText() (*Activation, error) {
+	line, err := d.r.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	text := strings.TrimRight(line, "\r\n")
+	act := &Activation{Raw: []byte(line)}
+
+	if t, ok := plainActivationTypes[text]; ok {
+		act.Type = t
+		return act, nil
+	}
+	if strings.HasPrefix(text, "@") && len(text) > 1 && strings.ToUpper(text) == text {
+		// an activation type unknown to this package
+		act.Type = ActivationType(strings.ToLower(text[1:]))
+		return act, nil
+	}
+
+	act.Value = text
+	act.Type = ActivationTypeActionClicked
+	if d.opts != nil && actionIndex(d.opts.Actions, text) < 0 {
+		if !d.opts.Reply {
+			// neither an action nor a reply, such as a message of alerter
+			return nil, fmt.Errorf("%w: unexpected output %q", ErrBadActivationPayload, text)
+		}
+		act.Type = ActivationTypeReplied
+	}
+	d.resolveAction(act)
+	return act, nil
+}
+
+// resolveAction resolves a clicked action, printed by its label as
+// displayed by alerter or by its index, to the element of the delivered
+// Options.Actions and its index.
+func (d *Decoder) resolveAction(act *Activation) {
+	if d.opts == nil || act.Type != ActivationTypeActionClicked {
+		return
+	}
+	actions := d.opts.Actions
+	i := act.ValueIndex
+	if i < 0 || i >= len(actions) || (act.Value != "" && !isActionLabel(actions[i], act.Value)) {
+		i = actionIndex(actions, act.Value)
+	}
+	if i >= 0 {
+		act.ValueIndex = i
+		act.Value = d.opts.Actions[i]
+	}
+}
+
+// actionIndex returns the index of the action labeled label, as given or
+// as displayed by alerter, -1 when not found.
+func actionIndex(actions []string, label string) int {
+	for i, action := range actions {
+		if isActionLabel(action, label) {
+			return i
+		}
+	}
+	return -1
+}
+
+func isActionLabel(action, label string) bool {
+	return action == label || alerterActionLabel(action) == label
+}
@@ -0,0 +1,276 @@
+package gosxalerter
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+func TestDecodeActivation(t *testing.T) {
+	actions := &Options{Actions: []string{"a,b", "Later"}}
+	reply := &Options{Reply: true, Actions: []string{"Send"}}
+
+	tests := []struct {
+		name      string
+		data      string
+		opts      *Options
+		wantType  ActivationType
+		wantValue string
+		wantIndex int
+		wantErr   error
+	}{
+		{
+			name:      "json",
+			data:      `{"activationType":"replied","activationValue":"v1.2"}`,
+			wantType:  ActivationTypeReplied,
+			wantValue: "v1.2",
+		},
+		{
+			name:      "json action index as string",
+			data:      `{"activationType":"actionClicked","activationValue":"Later","activationValueIndex":"1"}` + "\n",
+			opts:      actions,
+			wantType:  ActivationTypeActionClicked,
+			wantValue: "Later",
+			wantIndex: 1,
+		},
+		{
+			name:      "json encoded comma",
+			data:      `{"activationType":"actionClicked","activationValue":"a` + alerterCommaSubstitute + `b"}`,
+			opts:      actions,
+			wantType:  ActivationTypeActionClicked,
+			wantValue: "a,b",
+		},
+		{
+			name:      "json braces in strings",
+			data:      `{"activationType":"replied","activationValue":"} \" {"}`,
+			wantType:  ActivationTypeReplied,
+			wantValue: `} " {`,
+		},
+		{
+			name:    "json without type",
+			data:    `{"activationValue":"x"}`,
+			wantErr: ErrEmptyActivation,
+		},
+		{
+			name:     "plain closed",
+			data:     "@CLOSED\n",
+			opts:     actions,
+			wantType: ActivationTypeClosed,
+		},
+		{
+			name:     "plain timeout with CRLF",
+			data:     "@TIMEOUT\r\n",
+			wantType: ActivationTypeTimeOut,
+		},
+		{
+			name:     "plain contents clicked without newline",
+			data:     "@CONTENTCLICKED",
+			wantType: ActivationTypeContentsClicked,
+		},
+		{
+			name:     "plain unknown type",
+			data:     "@SNOOZED\n",
+			opts:     actions,
+			wantType: "snoozed",
+		},
+		{
+			name:      "plain action label",
+			data:      "Later\n",
+			opts:      actions,
+			wantType:  ActivationTypeActionClicked,
+			wantValue: "Later",
+			wantIndex: 1,
+		},
+		{
+			name:      "plain encoded action label",
+			data:      "a" + alerterCommaSubstitute + "b\n",
+			opts:      actions,
+			wantType:  ActivationTypeActionClicked,
+			wantValue: "a,b",
+		},
+		{
+			name:      "plain reply",
+			data:      "looks good\n",
+			opts:      reply,
+			wantType:  ActivationTypeReplied,
+			wantValue: "looks good",
+		},
+		{
+			name:      "plain action of a reply alert",
+			data:      "Send\n",
+			opts:      reply,
+			wantType:  ActivationTypeActionClicked,
+			wantValue: "Send",
+		},
+		{
+			name:      "plain word without options",
+			data:      "hello\n",
+			wantType:  ActivationTypeActionClicked,
+			wantValue: "hello",
+		},
+		{
+			name:    "plain word matching no action",
+			data:    "hello\n",
+			opts:    actions,
+			wantErr: ErrBadActivationPayload,
+		},
+		{
+			name:    "plain word without actions",
+			data:    "hello\n",
+			opts:    &Options{},
+			wantErr: ErrBadActivationPayload,
+		},
+		{
+			name:    "empty",
+			data:    " \n\r\n",
+			wantErr: ErrEmptyActivation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			act, err := DecodeActivation([]byte(tt.data), tt.opts)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if act.Type != tt.wantType || act.Value != tt.wantValue || act.ValueIndex != tt.wantIndex {
+				t.Errorf("got %q %q %d, want %q %q %d",
+					act.Type, act.Value, act.ValueIndex, tt.wantType, tt.wantValue, tt.wantIndex)
+			}
+		})
+	}
+}
+
+func TestDecoderSplitWrites(t *testing.T) {
+	stream := `{"activationType":"actionClicked",` + "\n" + `"activationValue":"Later"}` + "\n@CLOSED\nLater\r\n"
+	want := []struct {
+		typ   ActivationType
+		value string
+	}{
+		{ActivationTypeActionClicked, "Later"},
+		{ActivationTypeClosed, ""},
+		{ActivationTypeActionClicked, "Later"},
+	}
+
+	readers := map[string]func(io.Reader) io.Reader{
+		"one byte": iotest.OneByteReader,
+		"half":     iotest.HalfReader,
+		"data err": iotest.DataErrReader,
+	}
+	for name, wrap := range readers {
+		t.Run(name, func(t *testing.T) {
+			d := NewDecoder(wrap(strings.NewReader(stream)), &Options{Actions: []string{"Now", "Later"}})
+			for i, w := range want {
+				act, err := d.Decode()
+				if err != nil {
+					t.Fatalf("activation %d: %v", i, err)
+				}
+				if act.Type != w.typ || act.Value != w.value {
+					t.Errorf("activation %d: got %q %q, want %q %q", i, act.Type, act.Value, w.typ, w.value)
+				}
+			}
+			if _, err := d.Decode(); err != io.EOF {
+				t.Errorf("end of stream: error = %v, want io.EOF", err)
+			}
+		})
+	}
+}
+
+func TestDecoderTruncatedJSON(t *testing.T) {
+	_, err := NewDecoder(strings.NewReader(`{"activationType":"clo`), nil).Decode()
+	if err != io.ErrUnexpectedEOF {
+		t.Fatalf("error = %v, want io.ErrUnexpectedEOF", err)
+	}
+}
+
+func FuzzDecodeActivation(f *testing.F) {
+	for _, seed := range []string{
+		`{"activationType":"replied","activationValue":"v1.2"}`,
+		`{"activationType":"actionClicked","activationValue":"b","activationValueIndex":"1"}`,
+		`{"activationType":"actionClicked","activationValueIndex":7}`,
+		`{"activationType":"closed","activationAt":"2015-12-22 10:00:05 +0100"}`,
+		"@CLOSED\n",
+		"@TIMEOUT\r\n",
+		"@SNOOZED",
+		"Later\n",
+		"a‚b\n",
+		"hello",
+		"{",
+		"",
+	} {
+		f.Add([]byte(seed), false)
+		f.Add([]byte(seed), true)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte, withOptions bool) {
+		var opts *Options
+		if withOptions {
+			opts = &Options{Actions: []string{"a,b", "Later"}}
+		}
+		act, err := DecodeActivation(data, opts)
+		if err != nil {
+			return
+		}
+		if act.Type == "" {
+			t.Fatalf("activation without type from %q", data)
+		}
+		if opts != nil && act.Type == ActivationTypeActionClicked {
+			// a known action is reported with its index
+			if i := actionIndex(opts.Actions, act.Value); i >= 0 && act.ValueIndex != i {
+				t.Fatalf("action %q does not match index %d", act.Value, i)
+			}
+		}
+
+		encoded, err := act.MarshalJSON()
+		if err != nil {
+			t.Fatalf("marshal %+v: %v", act, err)
+		}
+		again, err := DecodeActivation(encoded, nil)
+		if err != nil {
+			t.Fatalf("decode %s: %v", encoded, err)
+		}
+		if again.Type != act.Type {
+			t.Fatalf("type %q became %q through %s", act.Type, again.Type, encoded)
+		}
+	})
+}
+
+func TestMarshalActivationValue(t *testing.T) {
+	at := time.Date(2015, 12, 22, 10, 0, 0, 0, time.FixedZone("", 3600))
+	activations := []Activation{
+		{Type: ActivationTypeActionClicked, Value: "Later", ValueIndex: 1, At: at},
+		{Type: ActivationTypeClosed},
+	}
+	// Values, not only pointers, are encoded as alerter prints them.
+	encoded, err := json.Marshal(activations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"activationType":"actionClicked","activationAt":"2015-12-22 10:00:00 +0100",` +
+		`"activationValue":"Later","activationValueIndex":1},{"activationType":"closed"}]`
+	if string(encoded) != want {
+		t.Errorf("encoded %s\nwant %s", encoded, want)
+	}
+
+	pointer, err := json.Marshal(&activations[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := json.Marshal(activations[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(pointer) != string(value) {
+		t.Errorf("pointer encoded %s, value %s", pointer, value)
+	}
+}
@@ -0,0 +1,31 @@
+package gosxalerter
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+)
+
+// The alerter binary is built from https://github.com/vjeantet/alerter,
+// regenerate it with a checkout of the alerter repository:
+//
+//	ALERTER_SRC=/path/to/alerter go generate
+
+//go:generate go run ./internal/genalerter -project ${ALERTER_SRC} -o assets/alerter
+
+//go:embed assets/alerter
+var alerterBinary []byte
+
+var alerterDigest = sha256.Sum256(alerterBinary)
+
+// AlerterBinary returns a copy of the alerter binary embedded in the
+// package, as written to disk by Install.
+func AlerterBinary() []byte {
+	return append([]byte(nil), alerterBinary...)
+}
+
+// AlerterSHA256 returns the hex encoded SHA-256 digest of the embedded
+// alerter binary.
+func AlerterSHA256() string {
+	return hex.EncodeToString(alerterDigest[:])
+}
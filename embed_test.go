@@ -0,0 +1,21 @@
+package gosxalerter
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestAlerterSHA256File(t *testing.T) {
+	data, err := ioutil.ReadFile("assets/alerter.sha256")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) != 2 || fields[1] != "alerter" {
+		t.Fatalf("assets/alerter.sha256 is not a sha256sum line for alerter: %q", data)
+	}
+	if fields[0] != AlerterSHA256() {
+		t.Errorf("assets/alerter.sha256 holds %s, the embedded binary is %s, regenerate it", fields[0], AlerterSHA256())
+	}
+}
@@ -0,0 +1,48 @@
+package gosxalerter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrBackendCrashed reports a notification backend which stopped
+	// before the alert was activated.
+	ErrBackendCrashed = errors.New("notification backend crashed")
+	// ErrBadActivationPayload reports a backend answer which could not be
+	// decoded as an Activation.
+	ErrBadActivationPayload = errors.New("bad activation payload")
+)
+
+// BackendError is the Err of an ActivationTypeFailed Activation.
+type BackendError struct {
+	Err      error  // ErrBackendCrashed or ErrBadActivationPayload
+	ExitCode int    // Exit code of the backend process, -1 when unknown
+	Stderr   string // Captured standard error of the backend process
+	Output   []byte // Raw answer of the backend
+	Cause    error  // Underlying error, when any
+}
+
+func (e *BackendError) Error() string {
+	msg, cause := e.Err.Error(), e.Cause
+	if cause != nil && errors.Is(cause, e.Err) {
+		// the cause already wraps Err, do not repeat it
+		msg, cause = cause.Error(), nil
+	}
+	if e.ExitCode > 0 {
+		msg += fmt.Sprintf(" (exit code %d)", e.ExitCode)
+	}
+	if cause != nil {
+		msg += ": " + cause.Error()
+	}
+	if stderr := strings.TrimSpace(e.Stderr); stderr != "" {
+		msg += ": " + stderr
+	}
+	return msg
+}
+
+// Unwrap allows errors.Is and errors.As to match Err and Cause.
+func (e *BackendError) Unwrap() []error {
+	return []error{e.Err, e.Cause}
+}
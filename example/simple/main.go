@@ -1,7 +1,7 @@
 package main
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"time"
 
@@ -12,22 +12,21 @@ func main() {
 	alert, _ := gosxalerter.New("Name this release please")
 	alert.Options.Reply = true
 
-	activationChan, err := alert.Deliver()
-	if err != nil {
-		log.Fatalln("error:", err)
-	}
-
 	// This is for example purpose, you can set a timeout options on an alert
 	// when needed.
-	select {
-	case activation := <-activationChan:
-		log.Printf("Type : %s", activation.Type)
-		log.Printf("Value : %s", activation.Value)
-		log.Printf("Activated at : %s", activation.At)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
 
-	case <-time.After(5 * time.Second):
-		fmt.Println("BOOM!")
-		alert.Close()
+	activation, err := alert.DeliverAndWaitContext(ctx)
+	if err == context.DeadlineExceeded {
+		log.Println("BOOM!")
+		return
+	}
+	if err != nil {
+		log.Fatalln("error:", err)
 	}
 
+	log.Printf("Type : %s", activation.Type)
+	log.Printf("Value : %s", activation.Value)
+	log.Printf("Activated at : %s", activation.At)
 }
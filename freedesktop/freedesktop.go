@@ -0,0 +1,344 @@
+// Package freedesktop delivers gosxalerter alerts through the
+// org.freedesktop.Notifications D-Bus service available on most Linux
+// desktops.
+//
+//	backend, err := freedesktop.New()
+//	if err != nil {
+//		log.Fatalln("error:", err)
+//	}
+//	gosxalerter.DefaultBackend = backend
+package freedesktop
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+)
+
+const (
+	busName  = "org.freedesktop.Notifications"
+	busPath  = dbus.ObjectPath("/org/freedesktop/Notifications")
+	busIface = "org.freedesktop.Notifications"
+
+	// actionDefault is the key of the action invoked when the body of the
+	// notification is clicked.
+	actionDefault = "default"
+	// actionReply is the inline reply action supported by KDE Plasma.
+	actionReply = "inline-reply"
+)
+
+// Reasons sent with the NotificationClosed signal.
+const (
+	closedExpired   uint32 = 1
+	closedDismissed uint32 = 2
+	closedByCall    uint32 = 3
+)
+
+// Backend is a gosxalerter.Backend talking to a notification server over
+// D-Bus.
+type Backend struct {
+	AppName string // Application name sent to the server, defaults to the program name
+
+	conn    *dbus.Conn
+	signals chan *dbus.Signal
+
+	mu            sync.Mutex
+	notifications map[uint32]*notification
+	groups        map[string]uint32
+}
+
+type notification struct {
+	backend     *Backend
+	id          uint32
+	opts        *gosxalerter.Options
+	deliveredAt time.Time
+	activation  chan *gosxalerter.Activation
+}
+
+// New connects to the session bus and returns a Backend using it.
+func New() (*Backend, error) {
+	conn, err := dbus.ConnectSessionBus()
+	if err != nil {
+		return nil, err
+	}
+	b, err := NewConn(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return b, nil
+}
+
+// NewConn returns a Backend using an already connected bus, which is
+// handy to talk to a private bus or a fake notification server.
+func NewConn(conn *dbus.Conn) (*Backend, error) {
+	b := &Backend{
+		AppName:       filepath.Base(os.Args[0]),
+		conn:          conn,
+		signals:       make(chan *dbus.Signal, 16),
+		notifications: make(map[uint32]*notification),
+		groups:        make(map[string]uint32),
+	}
+
+	err := conn.AddMatchSignal(
+		dbus.WithMatchObjectPath(busPath),
+		dbus.WithMatchInterface(busIface),
+	)
+	if err != nil {
+		return nil, err
+	}
+	conn.Signal(b.signals)
+	go b.watch()
+
+	return b, nil
+}
+
+// Close closes the bus connection, pending notifications then fail with
+// gosxalerter.ErrBackendCrashed.
+func (b *Backend) Close() error {
+	return b.conn.Close()
+}
+
+// NotifyCall holds the arguments of a Notify call, as returned by
+// Preview.
+type NotifyCall struct {
+	AppName       string
+	ReplacesID    uint32
+	AppIcon       string
+	Summary       string
+	Body          string
+	Actions       []string
+	Hints         map[string]interface{} // Sent as variants of their values
+	ExpireTimeout int32                  // milliseconds
+}
+
+// variants returns the hints of the call as D-Bus variants.
+func (c *NotifyCall) variants() map[string]dbus.Variant {
+	hints := make(map[string]dbus.Variant, len(c.Hints))
+	for name, value := range c.Hints {
+		hints[name] = dbus.MakeVariant(value)
+	}
+	return hints
+}
+
+// Limits returns the lengths displayed by common notification servers.
+func (b *Backend) Limits() gosxalerter.Limits {
+	return gosxalerter.Limits{Title: 64, Subtitle: 64, Message: 400}
+}
+
+// Preview returns the *NotifyCall Deliver would send for opts.
+func (b *Backend) Preview(opts *gosxalerter.Options) (interface{}, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.notifyCall(opts)
+}
+
+// Deliver sends a Notify call built from opts.
+func (b *Backend) Deliver(opts *gosxalerter.Options) (gosxalerter.Notification, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, err := b.notifyCall(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var id uint32
+	call := b.conn.Object(busName, busPath).Call(busIface+".Notify", 0,
+		c.AppName, c.ReplacesID, c.AppIcon, c.Summary, c.Body, c.Actions, c.variants(), c.ExpireTimeout)
+	if err := call.Store(&id); err != nil {
+		return nil, err
+	}
+
+	n := &notification{
+		backend:     b,
+		id:          id,
+		opts:        opts,
+		deliveredAt: time.Now(),
+		activation:  make(chan *gosxalerter.Activation, 1),
+	}
+	if old, ok := b.notifications[id]; ok && old != n {
+		old.activate(&gosxalerter.Activation{Type: gosxalerter.ActivationTypeClosed})
+	}
+	b.notifications[id] = n
+	if opts.Group != "" {
+		b.groups[opts.Group] = id
+	}
+
+	return n, nil
+}
+
+// notifyCall builds the Notify call for opts, b.mu must be held.
+func (b *Backend) notifyCall(opts *gosxalerter.Options) (*NotifyCall, error) {
+	if opts.Message == "" {
+		return nil, errors.New("Please specifiy a proper message argument.")
+	}
+
+	body := opts.Message
+	if opts.Subtitle != "" {
+		body = opts.Subtitle + "\n" + body
+	}
+
+	actions := []string{actionDefault, ""}
+	for i, label := range opts.Actions {
+		actions = append(actions, strconv.Itoa(i), label)
+	}
+	if opts.Reply {
+		actions = append(actions, actionReply, opts.ReplyPlaceHolder)
+	}
+
+	hints := map[string]interface{}{}
+	if opts.ContentImage != "" {
+		hints["image-path"] = opts.ContentImage
+	}
+	if opts.Reply && opts.ReplyPlaceHolder != "" {
+		hints["x-kde-reply-placeholder-text"] = opts.ReplyPlaceHolder
+	}
+	switch opts.Sound {
+	case "":
+	case gosxalerter.SoundDefault:
+		hints["sound-name"] = "message-new-instant"
+	default:
+		hints["sound-name"] = string(opts.Sound)
+	}
+
+	var replaces uint32
+	if opts.Group != "" {
+		replaces = b.groups[opts.Group]
+	}
+
+	return &NotifyCall{
+		AppName:    b.AppName,
+		ReplacesID: replaces,
+		AppIcon:    opts.AppIcon,
+		Summary:    opts.Title,
+		Body:       body,
+		Actions:    actions,
+		Hints:      hints,
+		// alerter never expires a notification without timeout, 0 means
+		// the same to the notification server.
+		ExpireTimeout: int32(opts.TimeoutIn(time.Millisecond)),
+	}, nil
+}
+
+// Remove closes the notification delivered with the group ID.
+func (b *Backend) Remove(group string) error {
+	b.mu.Lock()
+	id, ok := b.groups[group]
+	b.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return b.closeNotification(id)
+}
+
+func (b *Backend) closeNotification(id uint32) error {
+	return b.conn.Object(busName, busPath).Call(busIface+".CloseNotification", 0, id).Err
+}
+
+// watch turns the signals of the notification server into activations.
+func (b *Backend) watch() {
+	for s := range b.signals {
+		if s.Path != busPath || len(s.Body) < 2 {
+			continue
+		}
+		id, ok := s.Body[0].(uint32)
+		if !ok {
+			continue
+		}
+
+		act := &gosxalerter.Activation{}
+		switch s.Name {
+		case busIface + ".ActionInvoked":
+			key, _ := s.Body[1].(string)
+			act.Type = gosxalerter.ActivationTypeActionClicked
+			switch key {
+			case actionDefault:
+				act.Type = gosxalerter.ActivationTypeContentsClicked
+			case actionReply:
+				// wait for the NotificationReplied signal
+				continue
+			default:
+				i, err := strconv.Atoi(key)
+				if err != nil {
+					continue
+				}
+				act.ValueIndex = i
+			}
+		case busIface + ".NotificationReplied":
+			act.Type = gosxalerter.ActivationTypeReplied
+			act.Value, _ = s.Body[1].(string)
+		case busIface + ".NotificationClosed":
+			reason, _ := s.Body[1].(uint32)
+			act.Type = gosxalerter.ActivationTypeClosed
+			if reason == closedExpired {
+				act.Type = gosxalerter.ActivationTypeTimeOut
+			}
+		default:
+			continue
+		}
+
+		b.mu.Lock()
+		n, ok := b.notifications[id]
+		if ok {
+			delete(b.notifications, id)
+			if group := n.opts.Group; group != "" && b.groups[group] == id {
+				delete(b.groups, group)
+			}
+		}
+		b.mu.Unlock()
+		if !ok {
+			continue
+		}
+
+		if act.Type == gosxalerter.ActivationTypeActionClicked {
+			if i := act.ValueIndex; i >= 0 && i < len(n.opts.Actions) {
+				act.Value = n.opts.Actions[i]
+			}
+			// Servers keep resident notifications on screen after an action.
+			go b.closeNotification(id)
+		}
+		n.activate(act)
+	}
+
+	// The bus connection is gone, nothing will activate the pending
+	// notifications anymore.
+	b.mu.Lock()
+	pending := b.notifications
+	b.notifications = make(map[uint32]*notification)
+	b.groups = make(map[string]uint32)
+	b.mu.Unlock()
+	for _, n := range pending {
+		n.activate(&gosxalerter.Activation{
+			Type: gosxalerter.ActivationTypeFailed,
+			Err: &gosxalerter.BackendError{
+				Err:      gosxalerter.ErrBackendCrashed,
+				ExitCode: -1,
+				Cause:    errors.New("D-Bus connection closed"),
+			},
+		})
+	}
+}
+
+func (n *notification) activate(act *gosxalerter.Activation) {
+	act.EffectiveTimeout = time.Duration(n.opts.TimeoutIn(time.Millisecond)) * time.Millisecond
+	act.DeliveredAt = n.deliveredAt
+	act.At = time.Now()
+	n.activation <- act
+	close(n.activation)
+}
+
+func (n *notification) Activations() <-chan *gosxalerter.Activation {
+	return n.activation
+}
+
+// Close asks the notification server to close the notification.
+func (n *notification) Close() error {
+	return n.backend.closeNotification(n.id)
+}
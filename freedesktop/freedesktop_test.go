@@ -0,0 +1,279 @@
+package freedesktop
+
+import (
+	"bufio"
+	"encoding/json"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+)
+
+func TestPreviewJSON(t *testing.T) {
+	b := &Backend{AppName: "test"}
+	call, err := b.Preview(&gosxalerter.Options{
+		Title:        "Build",
+		Message:      "3 tests failed",
+		ContentImage: "/tmp/build.png",
+		Sound:        gosxalerter.SoundDefault,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(call)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"image-path":"/tmp/build.png"`, `"sound-name":"message-new-instant"`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("preview %s does not hold %s", data, want)
+		}
+	}
+}
+
+// sessionBus starts a private session bus, and returns its address. The
+// test is skipped when dbus-daemon is not available.
+func sessionBus(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("dbus-daemon"); err != nil {
+		t.Skip("dbus-daemon not available")
+	}
+	cmd := exec.Command("dbus-daemon", "--session", "--nofork", "--print-address=1")
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Start(); err != nil {
+		t.Skipf("dbus-daemon: %v", err)
+	}
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+
+	address, err := bufio.NewReader(stdout).ReadString('\n')
+	if err != nil {
+		t.Skipf("dbus-daemon: %v", err)
+	}
+	return strings.TrimSpace(address)
+}
+
+// fakeServer is an org.freedesktop.Notifications server sending the
+// notifications it receives on notified.
+type fakeServer struct {
+	conn     *dbus.Conn
+	notified chan *NotifyCall
+
+	mu   sync.Mutex
+	last uint32
+}
+
+func newFakeServer(t *testing.T, address string) *fakeServer {
+	t.Helper()
+	conn, err := dbus.Connect(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	s := &fakeServer{
+		conn:     conn,
+		notified: make(chan *NotifyCall, 8),
+	}
+	if err := conn.Export(s, busPath, busIface); err != nil {
+		t.Fatal(err)
+	}
+	reply, err := conn.RequestName(busName, dbus.NameFlagDoNotQueue)
+	if err != nil || reply != dbus.RequestNameReplyPrimaryOwner {
+		t.Fatalf("request name: %v %v", reply, err)
+	}
+	return s
+}
+
+func (s *fakeServer) Notify(appName string, replacesID uint32, appIcon, summary, body string, actions []string,
+	hints map[string]dbus.Variant, expireTimeout int32) (uint32, *dbus.Error) {
+	s.mu.Lock()
+	id := replacesID
+	if id == 0 {
+		s.last++
+		id = s.last
+	}
+	s.mu.Unlock()
+
+	call := &NotifyCall{
+		AppName:       appName,
+		ReplacesID:    replacesID,
+		AppIcon:       appIcon,
+		Summary:       summary,
+		Body:          body,
+		Actions:       actions,
+		Hints:         map[string]interface{}{},
+		ExpireTimeout: expireTimeout,
+	}
+	for name, value := range hints {
+		call.Hints[name] = value.Value()
+	}
+	s.notified <- call
+	return id, nil
+}
+
+func (s *fakeServer) CloseNotification(id uint32) *dbus.Error {
+	s.emit("NotificationClosed", id, closedByCall)
+	return nil
+}
+
+func (s *fakeServer) emit(signal string, args ...interface{}) {
+	s.conn.Emit(busPath, busIface+"."+signal, args...)
+}
+
+// wait returns the next notification received by the server.
+func (s *fakeServer) wait(t *testing.T) *NotifyCall {
+	t.Helper()
+	select {
+	case call := <-s.notified:
+		return call
+	case <-time.After(5 * time.Second):
+		t.Fatal("no notification received")
+		return nil
+	}
+}
+
+func TestFakeServer(t *testing.T) {
+	address := sessionBus(t)
+	server := newFakeServer(t, address)
+	conn, err := dbus.Connect(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewConn(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+
+	tests := []struct {
+		name        string
+		opts        []gosxalerter.Option
+		signals     func(id uint32)
+		wantType    gosxalerter.ActivationType
+		wantValue   string
+		wantTimeout time.Duration
+	}{
+		{
+			name:      "action",
+			opts:      []gosxalerter.Option{gosxalerter.WithActions("Yes", "No")},
+			signals:   func(id uint32) { server.emit("ActionInvoked", id, "1") },
+			wantType:  gosxalerter.ActivationTypeActionClicked,
+			wantValue: "No",
+		},
+		{
+			name:     "contents",
+			signals:  func(id uint32) { server.emit("ActionInvoked", id, actionDefault) },
+			wantType: gosxalerter.ActivationTypeContentsClicked,
+		},
+		{
+			name: "reply",
+			opts: []gosxalerter.Option{gosxalerter.WithReply("Version")},
+			signals: func(id uint32) {
+				server.emit("ActionInvoked", id, actionReply)
+				server.emit("NotificationReplied", id, "v1.2")
+			},
+			wantType:  gosxalerter.ActivationTypeReplied,
+			wantValue: "v1.2",
+		},
+		{
+			name:     "dismissed",
+			signals:  func(id uint32) { server.emit("NotificationClosed", id, closedDismissed) },
+			wantType: gosxalerter.ActivationTypeClosed,
+		},
+		{
+			name:        "expired",
+			opts:        []gosxalerter.Option{gosxalerter.WithTimeout(1500 * time.Microsecond)},
+			signals:     func(id uint32) { server.emit("NotificationClosed", id, closedExpired) },
+			wantType:    gosxalerter.ActivationTypeTimeOut,
+			wantTimeout: 2 * time.Millisecond,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := gosxalerter.New("hello", append(tt.opts, gosxalerter.WithBackend(b))...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			activationChan, err := a.Deliver()
+			if err != nil {
+				t.Fatal(err)
+			}
+			call := server.wait(t)
+
+			server.mu.Lock()
+			id := server.last
+			server.mu.Unlock()
+			tt.signals(id)
+
+			act := <-activationChan
+			if act.Type != tt.wantType || act.Value != tt.wantValue {
+				t.Errorf("activation %q %q, want %q %q", act.Type, act.Value, tt.wantType, tt.wantValue)
+			}
+			// Timeouts are rounded up to the millisecond of expire_timeout.
+			if act.EffectiveTimeout != tt.wantTimeout || time.Duration(call.ExpireTimeout)*time.Millisecond != tt.wantTimeout {
+				t.Errorf("effective timeout %s, expire timeout %dms, want %s", act.EffectiveTimeout, call.ExpireTimeout, tt.wantTimeout)
+			}
+		})
+	}
+
+	t.Run("close", func(t *testing.T) {
+		a, err := gosxalerter.New("hello", gosxalerter.WithBackend(b), gosxalerter.WithSound(gosxalerter.SoundDefault))
+		if err != nil {
+			t.Fatal(err)
+		}
+		activationChan, err := a.Deliver()
+		if err != nil {
+			t.Fatal(err)
+		}
+		call := server.wait(t)
+		if call.Summary != a.Options.Title || call.Body != "hello" || call.Hints["sound-name"] != "message-new-instant" {
+			t.Errorf("Notify call %+v", call)
+		}
+		if err := a.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if act := <-activationChan; act.Type != gosxalerter.ActivationTypeClosed {
+			t.Errorf("activation %q, want closed", act.Type)
+		}
+	})
+
+	t.Run("group", func(t *testing.T) {
+		first, err := gosxalerter.New("first", gosxalerter.WithBackend(b), gosxalerter.WithGroup("ci"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		firstChan, err := first.Deliver()
+		if err != nil {
+			t.Fatal(err)
+		}
+		server.wait(t)
+
+		second, err := first.Derive(gosxalerter.WithMessage("second"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := second.Deliver(); err != nil {
+			t.Fatal(err)
+		}
+		call := server.wait(t)
+		server.mu.Lock()
+		id := server.last
+		server.mu.Unlock()
+		if call.ReplacesID != id {
+			t.Errorf("second alert replaces %d, want %d", call.ReplacesID, id)
+		}
+		if act := <-firstChan; act.Type != gosxalerter.ActivationTypeClosed {
+			t.Errorf("replaced alert activated with %q, want closed", act.Type)
+		}
+	})
+}
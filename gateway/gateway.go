@@ -0,0 +1,377 @@
+// Package gateway exposes a gosxalerter backend over HTTP, so that
+// programs which can not display alerts, such as build agents or
+// containers, raise them on a desktop.
+//
+//	POST   /alerts          delivers the alert described by a JSON Options body
+//	GET    /alerts/{id}     waits for the activation of the alert
+//	DELETE /alerts/{id}     closes the alert
+//	DELETE /groups/{group}  removes the alerts of a group, as Alert.Remove does
+//
+// Every response is a JSON Status. GET answers 200 with the activation once
+// the alert is activated, or 202 when it is still displayed after the
+// wait, given as a duration such as ?wait=30s. When Token is set, requests
+// must be authenticated with an "Authorization: Bearer <Token>" header.
+//
+// Images given as local paths are not checked, so clients do not learn
+// which files exist on the gateway host. At most MaxAlerts alerts are
+// tracked, the oldest ones are closed and forgotten beyond it.
+//
+// A POST with an Idempotency-Key header delivers its alert once: posting
+// the same key again, such as when retrying after a lost response,
+// answers the status of the alert already delivered.
+//
+// Package remote provides the matching client backend.
+//
+//	http.Handle("/", gateway.New(nil))
+package gateway
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+)
+
+// Defaults of the Gateway settings.
+const (
+	DefaultMaxWait   = time.Minute
+	DefaultRetention = 10 * time.Minute
+	DefaultMaxAlerts = 1000
+)
+
+// maxBodySize limits the size of the Options posted.
+const maxBodySize = 1 << 20
+
+// Gateway is an http.Handler delivering the posted alerts through its
+// backend.
+type Gateway struct {
+	Backend   gosxalerter.Backend // Backend delivering the alerts, gosxalerter.DefaultBackend when nil
+	MaxWait   time.Duration       // Longest wait of a GET, DefaultMaxWait when 0
+	Retention time.Duration       // How long activations are kept, DefaultRetention when 0
+	Token     string              // Bearer token required from clients, when set
+	MaxAlerts int                 // Most alerts tracked, DefaultMaxAlerts when 0
+
+	manager *gosxalerter.Manager
+	mux     *http.ServeMux
+
+	mu     sync.Mutex
+	alerts map[string]*entry
+	posts  map[string]*posting
+}
+
+type entry struct {
+	alert      *gosxalerter.Alert
+	posted     time.Time
+	key        string
+	done       chan struct{}
+	activation *gosxalerter.Activation
+}
+
+// posting is the delivery of an alert posted with an Idempotency-Key. Its
+// entry is set, or left nil when the delivery failed, before done is
+// closed.
+type posting struct {
+	done  chan struct{}
+	entry *entry
+}
+
+// Status is the JSON body of the gateway responses.
+type Status struct {
+	ID         string                  `json:"id,omitempty"`
+	State      string                  `json:"state,omitempty"`
+	Activation *gosxalerter.Activation `json:"activation,omitempty"`
+	Error      string                  `json:"error,omitempty"`
+}
+
+// New returns a Gateway delivering alerts through backend,
+// gosxalerter.DefaultBackend when nil.
+func New(backend gosxalerter.Backend) *Gateway {
+	g := &Gateway{
+		Backend: backend,
+		manager: gosxalerter.NewManager(0),
+		mux:     http.NewServeMux(),
+		alerts:  make(map[string]*entry),
+		posts:   make(map[string]*posting),
+	}
+	g.mux.HandleFunc("POST /alerts", g.post)
+	g.mux.HandleFunc("GET /alerts/{id}", g.get)
+	g.mux.HandleFunc("DELETE /alerts/{id}", g.delete)
+	g.mux.HandleFunc("DELETE /groups/{group}", g.deleteGroup)
+	return g
+}
+
+// ServeHTTP serves the alerts API.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if g.Token != "" {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(g.Token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, errors.New("invalid token"))
+			return
+		}
+	}
+	g.mux.ServeHTTP(w, r)
+}
+
+// Shutdown stops accepting alerts and waits for the displayed ones to be
+// activated, as gosxalerter.Manager.Shutdown does.
+func (g *Gateway) Shutdown(ctx context.Context) error {
+	return g.manager.Shutdown(ctx)
+}
+
+// post delivers the alert described by the body.
+func (g *Gateway) post(w http.ResponseWriter, r *http.Request) {
+	var opts gosxalerter.Options
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(&opts); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	key := r.Header.Get("Idempotency-Key")
+	var p *posting
+	for key != "" && p == nil {
+		g.mu.Lock()
+		posted, ok := g.posts[key]
+		if !ok {
+			p = &posting{done: make(chan struct{})}
+			g.posts[key] = p
+		}
+		g.mu.Unlock()
+		if !ok {
+			break
+		}
+
+		select {
+		case <-posted.done:
+		case <-r.Context().Done():
+			return
+		}
+		if posted.entry != nil {
+			w.Header().Set("Location", "/alerts/"+posted.entry.alert.ID)
+			writeStatus(w, http.StatusCreated, posted.entry.status())
+			return
+		}
+		// The delivery failed, deliver again.
+	}
+
+	e, code, err := g.deliver(&opts)
+	if p != nil {
+		g.mu.Lock()
+		if e != nil {
+			e.key = key
+			p.entry = e
+		} else {
+			delete(g.posts, key)
+		}
+		g.mu.Unlock()
+		close(p.done)
+	}
+	if err != nil {
+		writeError(w, code, err)
+		return
+	}
+	w.Header().Set("Location", "/alerts/"+e.alert.ID)
+	writeStatus(w, http.StatusCreated, e.status())
+}
+
+// deliver delivers the alert described by opts, and returns its entry, or
+// the status code of the error.
+func (g *Gateway) deliver(opts *gosxalerter.Options) (*entry, int, error) {
+	alert, err := gosxalerter.NewWithOptions(opts,
+		gosxalerter.WithBackend(g.Backend),
+		gosxalerter.WithUncheckedImages(),
+	)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	// The alert outlives the request, it is closed by DELETE or Shutdown.
+	activationChan, err := g.manager.Deliver(context.Background(), alert)
+	switch {
+	case errors.Is(err, gosxalerter.ErrManagerShutdown):
+		return nil, http.StatusServiceUnavailable, err
+	case err != nil:
+		return nil, http.StatusBadGateway, err
+	}
+
+	e := &entry{
+		alert:  alert,
+		posted: time.Now(),
+		done:   make(chan struct{}),
+	}
+	g.mu.Lock()
+	g.alerts[alert.ID] = e
+	evicted := g.evict()
+	g.mu.Unlock()
+	go g.wait(e, activationChan)
+	if evicted != nil {
+		evicted.alert.Close()
+	}
+	return e, http.StatusCreated, nil
+}
+
+// evict forgets the oldest alert when more than MaxAlerts are tracked,
+// and returns it to be closed. g.mu must be held.
+func (g *Gateway) evict() *entry {
+	maxAlerts := g.MaxAlerts
+	if maxAlerts == 0 {
+		maxAlerts = DefaultMaxAlerts
+	}
+	if len(g.alerts) <= maxAlerts {
+		return nil
+	}
+	var oldest *entry
+	for _, e := range g.alerts {
+		if oldest == nil || e.posted.Before(oldest.posted) {
+			oldest = e
+		}
+	}
+	g.forget(oldest)
+	return oldest
+}
+
+// forget drops e and its idempotency key, g.mu must be held.
+func (g *Gateway) forget(e *entry) {
+	if g.alerts[e.alert.ID] == e {
+		delete(g.alerts, e.alert.ID)
+	}
+	if e.key != "" && g.posts[e.key] != nil && g.posts[e.key].entry == e {
+		delete(g.posts, e.key)
+	}
+}
+
+// wait records the activation of e, which is then kept for Retention.
+func (g *Gateway) wait(e *entry, activationChan <-chan *gosxalerter.Activation) {
+	activation := <-activationChan
+	g.mu.Lock()
+	e.activation = activation
+	g.mu.Unlock()
+	close(e.done)
+
+	retention := g.Retention
+	if retention == 0 {
+		retention = DefaultRetention
+	}
+	time.AfterFunc(retention, func() {
+		g.mu.Lock()
+		g.forget(e)
+		g.mu.Unlock()
+	})
+}
+
+// get waits for the activation of an alert.
+func (g *Gateway) get(w http.ResponseWriter, r *http.Request) {
+	e := g.lookup(w, r)
+	if e == nil {
+		return
+	}
+
+	maxWait := g.MaxWait
+	if maxWait == 0 {
+		maxWait = DefaultMaxWait
+	}
+	wait := maxWait
+	if s := r.URL.Query().Get("wait"); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		wait = min(max(d, 0), maxWait)
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-e.done:
+		writeStatus(w, http.StatusOK, e.status())
+	case <-timer.C:
+		writeStatus(w, http.StatusAccepted, e.status())
+	case <-r.Context().Done():
+	}
+}
+
+// delete closes an alert. Closing an alert already activated answers its
+// final status.
+func (g *Gateway) delete(w http.ResponseWriter, r *http.Request) {
+	e := g.lookup(w, r)
+	if e == nil {
+		return
+	}
+	if err := e.alert.Close(); err != nil {
+		switch e.alert.State() {
+		case gosxalerter.StateDelivering, gosxalerter.StateDisplayed:
+			writeError(w, http.StatusBadGateway, err)
+			return
+		}
+		select {
+		case <-e.done:
+		case <-r.Context().Done():
+			return
+		}
+	}
+	writeStatus(w, http.StatusOK, e.status())
+}
+
+// deleteGroup closes and removes the alerts of a group.
+func (g *Gateway) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	group := r.PathValue("group")
+	backend := g.Backend
+	if backend == nil {
+		backend = gosxalerter.DefaultBackend
+	}
+	if backend == nil {
+		writeError(w, http.StatusBadGateway, gosxalerter.ErrNoBackend)
+		return
+	}
+	err := errors.Join(g.manager.CloseGroup(group), backend.Remove(group))
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	writeStatus(w, http.StatusOK, &Status{})
+}
+
+// lookup returns the alert of the request, or answers 404.
+func (g *Gateway) lookup(w http.ResponseWriter, r *http.Request) *entry {
+	g.mu.Lock()
+	e, ok := g.alerts[r.PathValue("id")]
+	g.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, errors.New("unknown alert"))
+		return nil
+	}
+	return e
+}
+
+func (e *entry) status() *Status {
+	s := &Status{
+		ID:    e.alert.ID,
+		State: e.alert.State().String(),
+	}
+	select {
+	case <-e.done:
+		s.Activation = e.activation
+		if e.activation.Err != nil {
+			s.Error = e.activation.Err.Error()
+		}
+	default:
+	}
+	return s
+}
+
+func writeStatus(w http.ResponseWriter, code int, s *Status) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(s)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeStatus(w, code, &Status{Error: err.Error()})
+}
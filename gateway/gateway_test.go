@@ -0,0 +1,164 @@
+package gateway_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+	"github.com/vjeantet/gosx-alerter/gateway"
+	"github.com/vjeantet/gosx-alerter/gosxalertertest"
+)
+
+// request sends a request to the gateway and decodes its Status.
+func request(t *testing.T, g http.Handler, method, path, body string, header http.Header) (int, *gateway.Status) {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	rec := httptest.NewRecorder()
+	g.ServeHTTP(rec, req)
+
+	status := &gateway.Status{}
+	if err := json.Unmarshal(rec.Body.Bytes(), status); err != nil {
+		t.Fatalf("%s %s: decode %q: %v", method, path, rec.Body, err)
+	}
+	return rec.Code, status
+}
+
+func TestGatewayLifecycle(t *testing.T) {
+	backend := gosxalertertest.New()
+	g := gateway.New(backend)
+
+	code, posted := request(t, g, http.MethodPost, "/alerts", `{"message": "Deploy ?", "actions": ["Yes", "No"]}`, nil)
+	if code != http.StatusCreated || posted.ID == "" || posted.State != "displayed" {
+		t.Fatalf("POST: %d %+v", code, posted)
+	}
+	path := "/alerts/" + posted.ID
+
+	code, status := request(t, g, http.MethodGet, path+"?wait=10ms", "", nil)
+	if code != http.StatusAccepted || status.Activation != nil {
+		t.Fatalf("GET while displayed: %d %+v", code, status)
+	}
+
+	code, status = request(t, g, http.MethodDelete, path, "", nil)
+	if code != http.StatusOK {
+		t.Fatalf("DELETE: %d %+v", code, status)
+	}
+	code, status = request(t, g, http.MethodGet, path+"?wait=1s", "", nil)
+	if code != http.StatusOK || status.Activation == nil || status.Activation.Type != gosxalerter.ActivationTypeClosed {
+		t.Fatalf("GET after DELETE: %d %+v", code, status)
+	}
+
+	if code, _ := request(t, g, http.MethodGet, "/alerts/unknown", "", nil); code != http.StatusNotFound {
+		t.Errorf("GET unknown alert: %d, want 404", code)
+	}
+}
+
+func TestGatewayActivation(t *testing.T) {
+	backend := gosxalertertest.New()
+	backend.ClickAction(1)
+	g := gateway.New(backend)
+
+	_, posted := request(t, g, http.MethodPost, "/alerts", `{"message": "Deploy ?", "actions": ["Yes", "No"]}`, nil)
+	path := "/alerts/" + posted.ID
+
+	code, status := request(t, g, http.MethodGet, path+"?wait=1s", "", nil)
+	if code != http.StatusOK || !status.Activation.IsAction("No") {
+		t.Fatalf("GET: %d %+v", code, status)
+	}
+
+	// Closing an activated alert answers its final status.
+	code, status = request(t, g, http.MethodDelete, path, "", nil)
+	if code != http.StatusOK || status.State != "activated" || !status.Activation.IsAction("No") {
+		t.Fatalf("DELETE activated alert: %d %+v", code, status)
+	}
+}
+
+func TestGatewayAuth(t *testing.T) {
+	g := gateway.New(gosxalertertest.New())
+	g.Token = "secret"
+	body := `{"message": "hello"}`
+
+	for _, auth := range []string{"", "Bearer wrong", "secret"} {
+		header := http.Header{"Authorization": {auth}}
+		if code, _ := request(t, g, http.MethodPost, "/alerts", body, header); code != http.StatusUnauthorized {
+			t.Errorf("Authorization %q: %d, want 401", auth, code)
+		}
+	}
+	header := http.Header{"Authorization": {"Bearer secret"}}
+	if code, _ := request(t, g, http.MethodPost, "/alerts", body, header); code != http.StatusCreated {
+		t.Errorf("valid token: %d, want 201", code)
+	}
+}
+
+func TestGatewayIdempotencyKey(t *testing.T) {
+	backend := gosxalertertest.New()
+	g := gateway.New(backend)
+	header := http.Header{"Idempotency-Key": {"k1"}}
+
+	_, first := request(t, g, http.MethodPost, "/alerts", `{"message": "hello"}`, header)
+	code, again := request(t, g, http.MethodPost, "/alerts", `{"message": "hello"}`, header)
+	if code != http.StatusCreated || again.ID != first.ID {
+		t.Errorf("repeated POST: %d %+v, want the alert %s", code, again, first.ID)
+	}
+	if n := len(backend.Delivered()); n != 1 {
+		t.Errorf("delivered %d alerts, want 1", n)
+	}
+}
+
+func TestGatewayDoesNotCheckLocalFiles(t *testing.T) {
+	g := gateway.New(gosxalertertest.New())
+	body := `{"message": "hello", "appIcon": "/does/not/exist.png", "contentImage": "file:///etc/passwd"}`
+	if code, status := request(t, g, http.MethodPost, "/alerts", body, nil); code != http.StatusCreated {
+		t.Errorf("POST with local images: %d %+v, want 201", code, status)
+	}
+}
+
+func TestGatewayMaxAlerts(t *testing.T) {
+	backend := gosxalertertest.New()
+	g := gateway.New(backend)
+	g.MaxAlerts = 2
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		_, posted := request(t, g, http.MethodPost, "/alerts", `{"message": "hello"}`, nil)
+		ids = append(ids, posted.ID)
+	}
+	if code, _ := request(t, g, http.MethodGet, "/alerts/"+ids[0]+"?wait=0s", "", nil); code != http.StatusNotFound {
+		t.Errorf("oldest alert: %d, want 404", code)
+	}
+	for _, id := range ids[1:] {
+		if code, _ := request(t, g, http.MethodGet, "/alerts/"+id+"?wait=0s", "", nil); code != http.StatusAccepted {
+			t.Errorf("alert %s: %d, want 202", id, code)
+		}
+	}
+	deadline := time.Now().Add(time.Second)
+	for backend.Displayed() != 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if n := backend.Displayed(); n != 2 {
+		t.Errorf("%d alerts displayed, want the oldest one closed", n)
+	}
+}
+
+func TestGatewayDeleteGroup(t *testing.T) {
+	backend := gosxalertertest.New()
+	g := gateway.New(backend)
+
+	_, posted := request(t, g, http.MethodPost, "/alerts", `{"message": "hello", "group": "ci"}`, nil)
+	if code, status := request(t, g, http.MethodDelete, "/groups/ci", "", nil); code != http.StatusOK {
+		t.Fatalf("DELETE group: %d %+v", code, status)
+	}
+	_, status := request(t, g, http.MethodGet, "/alerts/"+posted.ID+"?wait=1s", "", nil)
+	if status.Activation == nil || status.Activation.Type != gosxalerter.ActivationTypeClosed {
+		t.Errorf("alert of the group: %+v, want closed", status)
+	}
+	if removed := backend.Removed(); len(removed) != 1 || removed[0] != "ci" {
+		t.Errorf("removed groups %q, want ci", removed)
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/vjeantet/gosx-alerter
+
+go 1.22
+
+require (
+	github.com/BurntSushi/toml v1.3.2
+	github.com/godbus/dbus/v5 v5.1.0
+	github.com/rivo/uniseg v0.4.7
+	gopkg.in/yaml.v3 v3.0.1
+)
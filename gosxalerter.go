@@ -1,38 +1,37 @@
 package gosxalerter
 
 import (
-	"encoding/json"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
-	"io/ioutil"
-	"log"
 	"os"
-	"os/exec"
 	"path/filepath"
-	"runtime"
 	"strconv"
-	"strings"
-	"syscall"
+	"sync"
+	"time"
 )
 
 type Sound string
 type ActivationType string
 
 const (
-	SoundDefault Sound = "'default'"
-	SoundBasso   Sound = "Basso"
-	SoundBlow    Sound = "Blow"
-	SoundBottle  Sound = "Bottle"
-	SoundFrog    Sound = "Frog"
-	SoundFunk    Sound = "Funk"
-	SoundGlass   Sound = "Glass"
-	SoundHero    Sound = "Hero"
-	SoundMorse   Sound = "Morse"
-	SoundPing    Sound = "Ping"
-	SoundPop     Sound = "Pop"
-	SoundPurr    Sound = "Purr"
-	SoundSosumi  Sound = "Sosumi"
-	SoundTink    Sound = "Tink"
+	SoundDefault   Sound = "'default'"
+	SoundBasso     Sound = "Basso"
+	SoundBlow      Sound = "Blow"
+	SoundBottle    Sound = "Bottle"
+	SoundFrog      Sound = "Frog"
+	SoundFunk      Sound = "Funk"
+	SoundGlass     Sound = "Glass"
+	SoundHero      Sound = "Hero"
+	SoundMorse     Sound = "Morse"
+	SoundPing      Sound = "Ping"
+	SoundPop       Sound = "Pop"
+	SoundPurr      Sound = "Purr"
+	SoundSosumi    Sound = "Sosumi"
+	SoundSubmarine Sound = "Submarine"
+	SoundTink      Sound = "Tink"
 )
 
 const (
@@ -41,228 +40,306 @@ const (
 	ActivationTypeContentsClicked ActivationType = "contentsClicked"
 	ActivationTypeActionClicked   ActivationType = "actionClicked"
 	ActivationTypeReplied         ActivationType = "replied"
+	ActivationTypeCanceled        ActivationType = "canceled" // The delivery context was canceled
+	ActivationTypeFailed          ActivationType = "failed"   // The backend failed, see Activation.Err
 )
 
+// State is the step of its lifecycle an Alert is in.
+type State int
+
+const (
+	StateNew        State = iota // Not delivered yet
+	StateDelivering              // Handed to the backend
+	StateDisplayed               // Displayed, waiting for an activation
+	StateActivated               // Clicked or replied by the user
+	StateClosed                  // Closed, timed out or canceled
+	StateFailed                  // The backend failed
+)
+
+var stateNames = []string{"new", "delivering", "displayed", "activated", "closed", "failed"}
+
+func (s State) String() string {
+	if s < 0 || int(s) >= len(stateNames) {
+		return "State(" + strconv.Itoa(int(s)) + ")"
+	}
+	return stateNames[s]
+}
+
 type Alert struct {
+	ID      string // Identifies the alert in its activations, generated when empty
 	Options *Options
-	cmd     *exec.Cmd
-}
-type Options struct {
-	Message          string   // required
-	Title            string   // Title of the notification
-	Subtitle         string   // Text under the title
-	Sound            Sound    // Sound triggered when alert pops up
-	Sender           string   // Send notification as a know osx app
-	Group            string   // Group notification ID
-	AppIcon          string   // Path or URL of image
-	ContentImage     string   // Path or URL of image
-	Actions          []string // One or more actions availables on the alert
-	Reply            bool     // Reply type alert
-	ReplyPlaceHolder string   // Reply placeholder
-	CloseLabel       string   // Change the Close button label
-	DropdownLabel    string   // When more than 1 action, you may customize the action dropdown label
-	Timeout          int      // Autoclose notification avec X seconds
+	Backend Backend // Backend used to deliver the alert, DefaultBackend when nil
+
+	mu              sync.Mutex
+	state           State
+	notification    Notification
+	closeRequested  bool
+	optionsErr      error // set by options failing while applied
+	uncheckedImages bool  // set by WithUncheckedImages
 }
 
-type Activation struct {
-	Type        ActivationType `json:"activationType"`       // What kind of event dismissed the alert
-	At          string         `json:"activationAt"`         // When did it happen ?
-	Value       string         `json:"activationValue"`      // Value of activation
-	DeliveredAt string         `json:"deliveredAt"`          // When displayed ?
-	ValueIndex  string         `json:"activationValueIndex"` // When Dismissed ?
+// Options of an alert. Field names are stable when marshaled to JSON,
+// YAML or TOML, Timeout being a duration string such as "30s".
+type Options struct {
+	Message          string        `json:"message,omitempty" yaml:"message,omitempty" toml:"message,omitempty"`                            // required
+	Title            string        `json:"title,omitempty" yaml:"title,omitempty" toml:"title,omitempty"`                                  // Title of the notification
+	Subtitle         string        `json:"subtitle,omitempty" yaml:"subtitle,omitempty" toml:"subtitle,omitempty"`                         // Text under the title
+	Sound            Sound         `json:"sound,omitempty" yaml:"sound,omitempty" toml:"sound,omitempty"`                                  // Sound triggered when alert pops up
+	Sender           string        `json:"sender,omitempty" yaml:"sender,omitempty" toml:"sender,omitempty"`                               // Send notification as a know osx app
+	Group            string        `json:"group,omitempty" yaml:"group,omitempty" toml:"group,omitempty"`                                  // Group notification ID
+	AppIcon          string        `json:"appIcon,omitempty" yaml:"appIcon,omitempty" toml:"appIcon,omitempty"`                            // Path or URL of image
+	ContentImage     string        `json:"contentImage,omitempty" yaml:"contentImage,omitempty" toml:"contentImage,omitempty"`             // Path or URL of image
+	Actions          []string      `json:"actions,omitempty" yaml:"actions,omitempty" toml:"actions,omitempty"`                            // One or more actions availables on the alert
+	Reply            bool          `json:"reply,omitempty" yaml:"reply,omitempty" toml:"reply,omitempty"`                                  // Reply type alert
+	ReplyPlaceHolder string        `json:"replyPlaceholder,omitempty" yaml:"replyPlaceholder,omitempty" toml:"replyPlaceholder,omitempty"` // Reply placeholder
+	CloseLabel       string        `json:"closeLabel,omitempty" yaml:"closeLabel,omitempty" toml:"closeLabel,omitempty"`                   // Change the Close button label
+	DropdownLabel    string        `json:"dropdownLabel,omitempty" yaml:"dropdownLabel,omitempty" toml:"dropdownLabel,omitempty"`          // When more than 1 action, you may customize the action dropdown label
+	Timeout          time.Duration `json:"timeout,omitempty" yaml:"timeout,omitempty" toml:"timeout,omitempty"`                            // Autoclose notification after Timeout, see TimeoutIn for rounding
 }
 
-func New(message string) (*Alert, error) {
-	if runtime.GOOS != "darwin" {
-		return nil, fmt.Errorf("gosx-alerter only works with OSX")
+// New returns an alert showing message, configured by opts. The alert is
+// titled after the program unless WithTitle is given. An error lists
+// the invalid options, as returned by Options.Validate.
+func New(message string, opts ...Option) (*Alert, error) {
+	a := &Alert{
+		ID:      newAlertID(),
+		Options: defaultOptions(message),
 	}
-
-	opts := &Options{
-		Title:            filepath.Base(os.Args[0]),
-		Message:          message,
-		Reply:            false,
-		ReplyPlaceHolder: "Reply",
-		Timeout:          0,
+	if err := a.apply(opts); err != nil {
+		return nil, err
 	}
+	return a, nil
+}
 
-	a := &Alert{
-		Options: opts,
+// NewWithOptions returns an alert using a copy of o, such as options
+// decoded from JSON, then configured by opts. Empty Title and
+// ReplyPlaceHolder get their defaults, as with New.
+func NewWithOptions(o *Options, opts ...Option) (*Alert, error) {
+	a := newAlertWithOptions(o.Clone())
+	if err := a.apply(opts); err != nil {
+		return nil, err
 	}
 	return a, nil
 }
 
 // DeliverAndWait display the alert, and returns an Activation when
-// the user or the OS interacts with the notification.
+// the user or the OS interacts with the notification. When the backend
+// fails, the ActivationTypeFailed Activation is returned with its Err.
 func (a *Alert) DeliverAndWait() (*Activation, error) {
-	activationChan, err := a.Deliver()
+	return a.DeliverAndWaitContext(context.Background())
+}
+
+// DeliverAndWaitContext is like DeliverAndWait but closes the alert when
+// ctx is done, it then returns an ActivationTypeCanceled Activation with
+// ctx.Err().
+func (a *Alert) DeliverAndWaitContext(ctx context.Context) (*Activation, error) {
+	activationChan, err := a.DeliverContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("can not deliver - %s", err.Error())
+		return nil, fmt.Errorf("can not deliver - %w", err)
 	}
 	activation := <-activationChan
+	switch activation.Type {
+	case ActivationTypeCanceled:
+		return activation, ctx.Err()
+	case ActivationTypeFailed:
+		return activation, activation.Err
+	}
 	return activation, nil
 }
 
 // Deliver display the alert, and returns a chan that will be feeded later
 // with Activation when user of OS interacts with the notification.
+// Once activated, closed or failed, the alert may be delivered again with
+// the same Options, a new chan is then returned.
 func (a *Alert) Deliver() (chan *Activation, error) {
-	if a.cmd != nil {
+	return a.deliver(context.Background())
+}
+
+// DeliverContext is like Deliver but closes the alert when ctx is done,
+// the chan is then feeded with an ActivationTypeCanceled Activation.
+func (a *Alert) DeliverContext(ctx context.Context) (<-chan *Activation, error) {
+	return a.deliver(ctx)
+}
+
+// deliver implements DeliverContext, its chan is bidirectional for
+// Deliver.
+func (a *Alert) deliver(ctx context.Context) (chan *Activation, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	backend := a.backend()
+	if backend == nil {
+		return nil, ErrNoBackend
+	}
+
+	if err := a.Options.validateFor(backend, !a.uncheckedImages); err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	if a.state == StateDelivering || a.state == StateDisplayed {
+		a.mu.Unlock()
 		return nil, fmt.Errorf("error: this alert is already delivered")
 	}
-	name, args, err := buildCommand(a)
+	a.state = StateDelivering
+	a.closeRequested = false
+	if a.ID == "" {
+		a.ID = newAlertID()
+	}
+	a.mu.Unlock()
+
+	var n Notification
+	var err error
+	if contextBackend, ok := backend.(ContextBackend); ok {
+		n, err = contextBackend.DeliverContext(ctx, fitOptions(backend, a.Options))
+	} else {
+		n, err = backend.Deliver(fitOptions(backend, a.Options))
+	}
 	if err != nil {
-		return nil, fmt.Errorf("error: %s", err)
+		a.mu.Lock()
+		a.state = StateFailed
+		a.mu.Unlock()
+		return nil, fmt.Errorf("error: %w", err)
 	}
 
-	a.cmd = exec.Command(name, args...)
+	deliveredAt := time.Now()
 
-	cmdOut, _ := a.cmd.StdoutPipe()
-	if err := a.cmd.Start(); err != nil {
-		return nil, err
+	a.mu.Lock()
+	a.notification = n
+	a.state = StateDisplayed
+	closeRequested := a.closeRequested
+	a.mu.Unlock()
+
+	if closeRequested {
+		n.Close()
 	}
 
-	activation := make(chan *Activation)
+	activation := make(chan *Activation, 1)
 
 	go func() {
-		cmdBytes, _ := ioutil.ReadAll(cmdOut)
-		a.cmd.Wait()
-		act := &Activation{}
-		if len(cmdBytes) > 0 {
-			json.Unmarshal(cmdBytes, &act)
+		var act *Activation
+		select {
+		case act = <-n.Activations():
+		case <-ctx.Done():
+			n.Close()
+			<-n.Activations()
+			act = &Activation{Type: ActivationTypeCanceled}
+		}
+		if act.DeliveredAt.IsZero() {
+			act.DeliveredAt = deliveredAt
+		}
+		if act.At.IsZero() {
+			act.At = time.Now()
 		}
+		act.AlertID = a.ID
+		act.Options = a.Options
+		act.Timeout = a.Options.Timeout
+
+		a.mu.Lock()
+		a.notification = nil
+		a.state = stateOf(act)
+		a.mu.Unlock()
+
 		activation <- act
 		close(activation)
-		a.cmd = nil
 	}()
 
 	return activation, nil
 }
 
-// Close a displayed alert
+// State returns the lifecycle step the alert is in.
+func (a *Alert) State() State {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.state
+}
+
+// Close a displayed alert, an alert being delivered is closed as soon as
+// it is displayed.
 func (a *Alert) Close() error {
-	if a.cmd != nil {
-		return a.cmd.Process.Signal(syscall.SIGINT)
+	a.mu.Lock()
+	switch a.state {
+	case StateDelivering:
+		a.closeRequested = true
+		a.mu.Unlock()
+		return nil
+	case StateDisplayed:
+		n := a.notification
+		a.mu.Unlock()
+		return n.Close()
 	}
+	a.mu.Unlock()
 
 	return fmt.Errorf("No alert currently running")
 }
 
-func buildCommand(a *Alert) (name string, arg []string, err error) {
-	commandTuples := make([]string, 0)
-
-	//check required commands
-	if a.Options.Message == "" {
-		return "", nil, errors.New("Please specifiy a proper message argument.")
-	} else {
-		commandTuples = append(commandTuples, []string{"-message", a.Options.Message}...)
-	}
-
-	//add closeLabel if found
-	if a.Options.CloseLabel != "" {
-		commandTuples = append(commandTuples, []string{"-closeLabel", a.Options.CloseLabel}...)
-	}
-
-	//add dropdownLabel if found
-	if a.Options.DropdownLabel != "" {
-		commandTuples = append(commandTuples, []string{"-dropdownLabel", a.Options.DropdownLabel}...)
-	}
-
-	//add title if found
-	if len(a.Options.Actions) > 0 {
-		commandTuples = append(commandTuples, []string{"-actions"}...)
-		commandTuples = append(commandTuples, strings.Join(a.Options.Actions, ","))
-	}
-
-	//add Reply if found
-	if a.Options.Reply == true {
-		commandTuples = append(commandTuples, []string{"-reply", a.Options.ReplyPlaceHolder}...)
-	}
-
-	//add Reply if found
-	if a.Options.Timeout > 0 {
-		commandTuples = append(commandTuples, []string{"-timeout", strconv.Itoa(a.Options.Timeout)}...)
-	}
-
-	//add title if found
-	if a.Options.Title != "" {
-		commandTuples = append(commandTuples, []string{"-title", a.Options.Title}...)
-	}
-
-	//add subtitle if found
-	if a.Options.Subtitle != "" {
-		commandTuples = append(commandTuples, []string{"-subtitle", a.Options.Subtitle}...)
-	}
+// running tells whether the alert is being delivered or displayed.
+func (a *Alert) running() bool {
+	state := a.State()
+	return state == StateDelivering || state == StateDisplayed
+}
 
-	//add sound if specified
-	if a.Options.Sound != "" {
-		commandTuples = append(commandTuples, []string{"-sound", string(a.Options.Sound)}...)
+// Remove removes the notifications sharing the alert Group from the
+// notification center.
+func (a *Alert) Remove() error {
+	if a.Options.Group == "" {
+		return errors.New("alert has no group")
 	}
-
-	//add group if specified
-	if a.Options.Group != "" {
-		commandTuples = append(commandTuples, []string{"-group", a.Options.Group}...)
+	backend := a.backend()
+	if backend == nil {
+		return ErrNoBackend
 	}
+	return backend.Remove(a.Options.Group)
+}
 
-	//add appIcon if specified
-	if a.Options.AppIcon != "" {
-		commandTuples = append(commandTuples, []string{"-appIcon", a.Options.AppIcon}...)
+// defaultOptions returns the options of an alert created by New.
+func defaultOptions(message string) *Options {
+	return &Options{
+		Title:            filepath.Base(os.Args[0]),
+		Message:          message,
+		Reply:            false,
+		ReplyPlaceHolder: "Reply",
+		Timeout:          0,
 	}
+}
 
-	//add contentImage if specified
-	if a.Options.ContentImage != "" {
-		commandTuples = append(commandTuples, []string{"-contentImage", a.Options.ContentImage}...)
+// newAlertWithOptions returns an alert using o, whose empty Title and
+// ReplyPlaceHolder are set to their defaults.
+func newAlertWithOptions(o *Options) *Alert {
+	defaults := defaultOptions(o.Message)
+	if o.Title == "" {
+		o.Title = defaults.Title
 	}
-
-	//add sender if specified
-	if strings.HasPrefix(strings.ToLower(a.Options.Sender), "com.") {
-		commandTuples = append(commandTuples, []string{"-sender", a.Options.Sender}...)
+	if o.ReplyPlaceHolder == "" {
+		o.ReplyPlaceHolder = defaults.ReplyPlaceHolder
 	}
-
-	commandTuples = append(commandTuples, []string{"-json"}...)
-
-	if len(commandTuples) == 0 {
-		return "", nil, errors.New("Please provide a Message and Type at a minimum.")
+	return &Alert{
+		ID:      newAlertID(),
+		Options: o,
 	}
-
-	return finalPath, commandTuples, nil
 }
 
-const (
-	executableFilename = "alerter"
-	tempDirSuffix      = "gosxalterter"
-)
-
-var (
-	finalPath string
-)
-
-func init() {
-	if runtime.GOOS == "darwin" {
-		if err := installAlerter(); err != nil {
-			log.Fatal(err.Error())
-		} else {
-			finalPath = filepath.Join(os.TempDir(), executableFilename)
-		}
-	}
+// newAlertID returns a random alert ID.
+func newAlertID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
 }
 
-func installAlerter() error {
-	finalPath := filepath.Join(os.TempDir(), executableFilename)
-
-	//if alerter already installed no-need to re-install
-	if _, err := os.Stat(finalPath); false == os.IsNotExist(err) {
-		return nil
-	}
-
-	alerterB, _ := alerterBytes()
-	err := ioutil.WriteFile(finalPath, alerterB, 0700)
-	if err != nil {
-		return errors.New("could not write alerter file")
+// stateOf returns the final State of an alert activated with act.
+func stateOf(act *Activation) State {
+	switch act.Type {
+	case ActivationTypeContentsClicked, ActivationTypeActionClicked, ActivationTypeReplied:
+		return StateActivated
+	case ActivationTypeFailed:
+		return StateFailed
 	}
+	return StateClosed
+}
 
-	err = os.Chmod(finalPath, 0755)
-	if err != nil {
-		return errors.New("could not make alerter executable")
+func (a *Alert) backend() Backend {
+	if a.Backend != nil {
+		return a.Backend
 	}
-
-	return nil
+	return DefaultBackend
 }
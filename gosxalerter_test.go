@@ -0,0 +1,293 @@
+package gosxalerter_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+	"github.com/vjeantet/gosx-alerter/gosxalertertest"
+)
+
+func TestDeliver(t *testing.T) {
+	backend := gosxalertertest.New()
+	backend.Reply("v1.2")
+	a, err := gosxalerter.New("Version ?", gosxalerter.WithBackend(backend), gosxalerter.WithReply(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Deliver keeps returning a bidirectional chan, as before backends.
+	var activationChan chan *gosxalerter.Activation
+	activationChan, err = a.Deliver()
+	if err != nil {
+		t.Fatal(err)
+	}
+	act := <-activationChan
+	if act.Type != gosxalerter.ActivationTypeReplied || act.Value != "v1.2" || act.AlertID != a.ID {
+		t.Errorf("activation %+v", act)
+	}
+	if _, ok := <-activationChan; ok {
+		t.Error("chan not closed after the activation")
+	}
+}
+
+// blockingBackend holds deliveries until release is closed, keeping their
+// alerts in StateDelivering.
+type blockingBackend struct {
+	*gosxalertertest.Backend
+	delivering chan struct{}
+	release    chan struct{}
+}
+
+func (b *blockingBackend) Deliver(opts *gosxalerter.Options) (gosxalerter.Notification, error) {
+	b.delivering <- struct{}{}
+	<-b.release
+	return b.Backend.Deliver(opts)
+}
+
+// activation waits for the activation sent on activations.
+func activation(t *testing.T, activations <-chan *gosxalerter.Activation) *gosxalerter.Activation {
+	t.Helper()
+	select {
+	case act := <-activations:
+		return act
+	case <-time.After(time.Second):
+		t.Fatal("no activation")
+		return nil
+	}
+}
+
+func TestAlertStates(t *testing.T) {
+	tests := []struct {
+		name   string
+		script func(*gosxalertertest.Backend)
+		want   gosxalerter.State
+	}{
+		{"action", func(b *gosxalertertest.Backend) { b.ClickAction(0) }, gosxalerter.StateActivated},
+		{"reply", func(b *gosxalertertest.Backend) { b.Reply("ok") }, gosxalerter.StateActivated},
+		{"dismissed", (*gosxalertertest.Backend).Dismiss, gosxalerter.StateClosed},
+		{"timeout", func(b *gosxalertertest.Backend) { b.TimeOut(0) }, gosxalerter.StateClosed},
+		{"crash", func(b *gosxalertertest.Backend) { b.Crash("boom", 2) }, gosxalerter.StateFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := gosxalertertest.New()
+			tt.script(backend)
+			a, err := gosxalerter.New("Deploy ?", gosxalerter.WithBackend(backend), gosxalerter.WithActions("Yes"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if state := a.State(); state != gosxalerter.StateNew {
+				t.Fatalf("state %s before delivery, want new", state)
+			}
+			activations, err := a.Deliver()
+			if err != nil {
+				t.Fatal(err)
+			}
+			activation(t, activations)
+			if state := a.State(); state != tt.want {
+				t.Errorf("state %s, want %s", state, tt.want)
+			}
+			if err := a.Close(); err == nil {
+				t.Error("Close of a finished alert succeeded")
+			}
+		})
+	}
+}
+
+func TestAlertDeliverFailure(t *testing.T) {
+	backend := gosxalertertest.New()
+	errDown := errors.New("notification center down")
+	backend.Fail(errDown)
+	a, err := gosxalerter.New("hello", gosxalerter.WithBackend(backend))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.Deliver(); !errors.Is(err, errDown) {
+		t.Fatalf("error = %v, want %v", err, errDown)
+	}
+	if state := a.State(); state != gosxalerter.StateFailed {
+		t.Errorf("state %s, want failed", state)
+	}
+}
+
+func TestAlertClose(t *testing.T) {
+	backend := gosxalertertest.New()
+	a, err := gosxalerter.New("hello", gosxalerter.WithBackend(backend))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Close(); err == nil {
+		t.Error("Close of an undelivered alert succeeded")
+	}
+	activations, err := a.Deliver()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state := a.State(); state != gosxalerter.StateDisplayed {
+		t.Fatalf("state %s, want displayed", state)
+	}
+	if _, err := a.Deliver(); err == nil {
+		t.Error("delivery of a displayed alert succeeded")
+	}
+
+	// Concurrent calls close the alert once, run with -race.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Close()
+			a.State()
+		}()
+	}
+	wg.Wait()
+	if act := activation(t, activations); act.Type != gosxalerter.ActivationTypeClosed {
+		t.Errorf("activation %q, want closed", act.Type)
+	}
+	if _, ok := <-activations; ok {
+		t.Error("more than one activation")
+	}
+	if state := a.State(); state != gosxalerter.StateClosed {
+		t.Errorf("state %s, want closed", state)
+	}
+}
+
+func TestAlertCloseWhileDelivering(t *testing.T) {
+	backend := &blockingBackend{
+		Backend:    gosxalertertest.New(),
+		delivering: make(chan struct{}),
+		release:    make(chan struct{}),
+	}
+	a, err := gosxalerter.New("hello", gosxalerter.WithBackend(backend))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	delivered := make(chan (<-chan *gosxalerter.Activation))
+	go func() {
+		activations, err := a.Deliver()
+		if err != nil {
+			t.Error(err)
+		}
+		delivered <- activations
+	}()
+	<-backend.delivering
+	if state := a.State(); state != gosxalerter.StateDelivering {
+		t.Fatalf("state %s, want delivering", state)
+	}
+	if err := a.Close(); err != nil {
+		t.Fatalf("Close while delivering: %v", err)
+	}
+	close(backend.release)
+
+	if act := activation(t, <-delivered); act.Type != gosxalerter.ActivationTypeClosed {
+		t.Errorf("activation %q, want the alert closed once displayed", act.Type)
+	}
+	if n := backend.Displayed(); n != 0 {
+		t.Errorf("%d alerts still displayed", n)
+	}
+}
+
+func TestAlertRedeliver(t *testing.T) {
+	backend := gosxalertertest.New()
+	backend.Dismiss()
+	backend.Reply("again")
+	a, err := gosxalerter.New("hello", gosxalerter.WithBackend(backend), gosxalerter.WithReply(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := a.Deliver()
+	if err != nil {
+		t.Fatal(err)
+	}
+	activation(t, first)
+	second, err := a.Deliver()
+	if err != nil {
+		t.Fatalf("redelivery: %v", err)
+	}
+	if second == first {
+		t.Fatal("redelivery returned the chan of the first delivery")
+	}
+	act := activation(t, second)
+	if act.Type != gosxalerter.ActivationTypeReplied || act.Value != "again" || act.AlertID != a.ID {
+		t.Errorf("activation %+v, want the reply to the same alert", act)
+	}
+	if state := a.State(); state != gosxalerter.StateActivated {
+		t.Errorf("state %s, want activated", state)
+	}
+	if n := len(backend.Delivered()); n != 2 {
+		t.Errorf("delivered %d alerts, want 2", n)
+	}
+}
+
+func TestDeliverAndWaitContextCanceled(t *testing.T) {
+	backend := gosxalertertest.New()
+	a, err := gosxalerter.New("hello", gosxalerter.WithBackend(backend))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	act, err := a.DeliverAndWaitContext(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want context.DeadlineExceeded", err)
+	}
+	if act == nil || act.Type != gosxalerter.ActivationTypeCanceled {
+		t.Fatalf("activation %+v, want canceled", act)
+	}
+	if n := backend.Displayed(); n != 0 {
+		t.Errorf("%d alerts still displayed, want the canceled alert closed", n)
+	}
+	if state := a.State(); state != gosxalerter.StateClosed {
+		t.Errorf("state %s, want closed", state)
+	}
+}
+
+func TestDeliverContextCanceled(t *testing.T) {
+	backend := gosxalertertest.New()
+	a, err := gosxalerter.New("hello", gosxalerter.WithBackend(backend))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	activations, err := a.DeliverContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	if act := activation(t, activations); act.Type != gosxalerter.ActivationTypeCanceled || act.AlertID != a.ID {
+		t.Errorf("activation %+v, want canceled", act)
+	}
+	if n := backend.Displayed(); n != 0 {
+		t.Errorf("%d alerts still displayed, want the canceled alert closed", n)
+	}
+}
+
+func TestDeliverContextAlreadyCanceled(t *testing.T) {
+	backend := gosxalertertest.New()
+	a, err := gosxalerter.New("hello", gosxalerter.WithBackend(backend))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := a.DeliverContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("DeliverContext error = %v, want context.Canceled", err)
+	}
+	if _, err := a.DeliverAndWaitContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("DeliverAndWaitContext error = %v, want context.Canceled", err)
+	}
+	if n := len(backend.Delivered()); n != 0 {
+		t.Errorf("delivered %d alerts, want none", n)
+	}
+	if state := a.State(); state != gosxalerter.StateNew {
+		t.Errorf("state %s, want new", state)
+	}
+}
@@ -0,0 +1,226 @@
+// Package gosxalertertest provides an in-memory gosxalerter.Backend for
+// testing code which delivers alerts.
+//
+//	backend := gosxalertertest.New()
+//	backend.Reply("v1.2")
+//	gosxalerter.DefaultBackend = backend
+//
+//	// code under test delivers an alert and receives the "v1.2" reply
+//
+//	if got := backend.Delivered(); len(got) != 1 {
+//		t.Fatalf("delivered %d alerts, want 1", len(got))
+//	}
+package gosxalertertest
+
+import (
+	"sync"
+	"time"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+)
+
+// Response scripts how the backend answers a delivered alert.
+type Response struct {
+	Type   gosxalerter.ActivationType
+	Value  string        // Reply text
+	Action int           // Index of the clicked action
+	After  time.Duration // Delay before the activation
+	Err    error         // When set, Deliver fails with Err
+	Crash  error         // When set, the alert fails with Crash as Activation.Err
+}
+
+// Backend is a fake gosxalerter.Backend answering alerts with scripted
+// responses. Alerts delivered without a scripted response stay displayed
+// until they are closed.
+type Backend struct {
+	mu        sync.Mutex
+	script    []Response
+	delivered []*gosxalerter.Options
+	removed   []string
+	open      map[*notification]bool
+}
+
+type notification struct {
+	backend     *Backend
+	opts        *gosxalerter.Options
+	deliveredAt time.Time
+	once        sync.Once
+	done        chan struct{}
+	activation  chan *gosxalerter.Activation
+}
+
+// New returns a Backend with an empty script.
+func New() *Backend {
+	return &Backend{
+		open: make(map[*notification]bool),
+	}
+}
+
+// Push appends responses to the script, each delivered alert consumes
+// the first one.
+func (b *Backend) Push(r ...Response) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.script = append(b.script, r...)
+}
+
+// Reply scripts the next alert to be answered with text.
+func (b *Backend) Reply(text string) {
+	b.Push(Response{Type: gosxalerter.ActivationTypeReplied, Value: text})
+}
+
+// ClickAction scripts a click on the action at index i of the next alert.
+func (b *Backend) ClickAction(i int) {
+	b.Push(Response{Type: gosxalerter.ActivationTypeActionClicked, Action: i})
+}
+
+// ClickContents scripts a click on the body of the next alert.
+func (b *Backend) ClickContents() {
+	b.Push(Response{Type: gosxalerter.ActivationTypeContentsClicked})
+}
+
+// Dismiss scripts the next alert to be closed by the user.
+func (b *Backend) Dismiss() {
+	b.Push(Response{Type: gosxalerter.ActivationTypeClosed})
+}
+
+// TimeOut scripts the next alert to time out after the given delay.
+func (b *Backend) TimeOut(after time.Duration) {
+	b.Push(Response{Type: gosxalerter.ActivationTypeTimeOut, After: after})
+}
+
+// Fail scripts the delivery of the next alert to fail with err.
+func (b *Backend) Fail(err error) {
+	b.Push(Response{Err: err})
+}
+
+// Crash scripts the next alert to fail as a crashed backend, with stderr
+// and exitCode reported in its *gosxalerter.BackendError.
+func (b *Backend) Crash(stderr string, exitCode int) {
+	b.Push(Response{
+		Type: gosxalerter.ActivationTypeFailed,
+		Crash: &gosxalerter.BackendError{
+			Err:      gosxalerter.ErrBackendCrashed,
+			ExitCode: exitCode,
+			Stderr:   stderr,
+		},
+	})
+}
+
+// Delivered returns a copy of the options of every delivered alert, in
+// delivery order.
+func (b *Backend) Delivered() []*gosxalerter.Options {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]*gosxalerter.Options(nil), b.delivered...)
+}
+
+// Removed returns the groups passed to Remove, in call order.
+func (b *Backend) Removed() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]string(nil), b.removed...)
+}
+
+// Pending returns the number of unused scripted responses.
+func (b *Backend) Pending() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.script)
+}
+
+// Displayed returns the number of delivered alerts not activated yet.
+func (b *Backend) Displayed() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.open)
+}
+
+// Deliver records opts and answers it with the next scripted response.
+func (b *Backend) Deliver(opts *gosxalerter.Options) (gosxalerter.Notification, error) {
+	o := *opts
+	o.Actions = append([]string(nil), opts.Actions...)
+
+	b.mu.Lock()
+	b.delivered = append(b.delivered, &o)
+	var r *Response
+	if len(b.script) > 0 {
+		r = &b.script[0]
+		b.script = b.script[1:]
+	}
+	if r != nil && r.Err != nil {
+		b.mu.Unlock()
+		return nil, r.Err
+	}
+	n := &notification{
+		backend:     b,
+		opts:        &o,
+		deliveredAt: time.Now(),
+		done:        make(chan struct{}),
+		activation:  make(chan *gosxalerter.Activation, 1),
+	}
+	b.open[n] = true
+	b.mu.Unlock()
+
+	if r != nil {
+		act := &gosxalerter.Activation{Type: r.Type, Value: r.Value, Err: r.Crash}
+		if r.Type == gosxalerter.ActivationTypeActionClicked {
+			act.ValueIndex = r.Action
+			if r.Action >= 0 && r.Action < len(o.Actions) {
+				act.Value = o.Actions[r.Action]
+			}
+		}
+		go func(after time.Duration) {
+			select {
+			case <-time.After(after):
+				n.activate(act)
+			case <-n.done:
+			}
+		}(r.After)
+	}
+
+	return n, nil
+}
+
+// Remove records the group and closes its displayed alerts.
+func (b *Backend) Remove(group string) error {
+	b.mu.Lock()
+	b.removed = append(b.removed, group)
+	var closing []*notification
+	for n := range b.open {
+		if n.opts.Group == group {
+			closing = append(closing, n)
+		}
+	}
+	b.mu.Unlock()
+
+	for _, n := range closing {
+		n.Close()
+	}
+	return nil
+}
+
+func (n *notification) activate(act *gosxalerter.Activation) {
+	n.once.Do(func() {
+		n.backend.mu.Lock()
+		delete(n.backend.open, n)
+		n.backend.mu.Unlock()
+
+		act.EffectiveTimeout = n.opts.Timeout
+		act.DeliveredAt = n.deliveredAt
+		act.At = time.Now()
+		close(n.done)
+		n.activation <- act
+		close(n.activation)
+	})
+}
+
+func (n *notification) Activations() <-chan *gosxalerter.Activation {
+	return n.activation
+}
+
+// Close closes the alert, as the alerter backend does when interrupted.
+func (n *notification) Close() error {
+	n.activate(&gosxalerter.Activation{Type: gosxalerter.ActivationTypeClosed})
+	return nil
+}
@@ -0,0 +1,123 @@
+package gosxalerter
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+)
+
+const (
+	executableFilename = "alerter"
+	tempDirSuffix      = "gosxalterter"
+)
+
+var (
+	installMu sync.Mutex
+)
+
+func init() {
+	if runtime.GOOS == "darwin" {
+		DefaultBackend = &AlerterBackend{}
+	}
+}
+
+// Install writes the embedded alerter binary into a sub directory of dir
+// named after its SHA-256 digest, and returns its path. An existing file
+// is reused only when its digest matches the embedded binary, otherwise it
+// is replaced atomically.
+//
+// Use it to install alerter ahead of the first delivery, then set
+// AlerterBackend.Path to the returned path.
+func Install(ctx context.Context, dir string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	digest := alerterDigest[:]
+	path := installPath(dir)
+	versionDir := filepath.Dir(path)
+
+	//if alerter already installed no-need to re-install
+	if ok, _ := fileDigestEquals(path, digest); ok {
+		return path, nil
+	}
+
+	if err := os.MkdirAll(versionDir, 0755); err != nil {
+		return "", fmt.Errorf("could not create alerter directory: %w", err)
+	}
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	if err := writeFileAtomic(path, alerterBinary, 0755); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// installPath returns the path of alerter installed by Install in dir.
+func installPath(dir string) string {
+	return filepath.Join(dir, tempDirSuffix+"-"+AlerterSHA256()[:16], executableFilename)
+}
+
+// installDefault installs alerter in os.TempDir(), the installed file is
+// verified before each use.
+func installDefault() (string, error) {
+	installMu.Lock()
+	defer installMu.Unlock()
+
+	return Install(context.Background(), os.TempDir())
+}
+
+// fileDigestEquals tells whether the SHA-256 digest of the file at path
+// is digest.
+func fileDigestEquals(path string, digest []byte) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return false, err
+	}
+	return bytes.Equal(h.Sum(nil), digest), nil
+}
+
+// writeFileAtomic writes data to a temporary file next to path, then
+// renames it to path, so that path is never seen partially written.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+"-")
+	if err != nil {
+		return fmt.Errorf("could not write alerter file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("could not write alerter file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("could not write alerter file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("could not write alerter file: %w", err)
+	}
+
+	if err := os.Chmod(tmp.Name(), perm); err != nil {
+		return fmt.Errorf("could not make alerter executable: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("could not install alerter file: %w", err)
+	}
+	return nil
+}
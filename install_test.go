@@ -0,0 +1,157 @@
+package gosxalerter
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestInstall(t *testing.T) {
+	dir := t.TempDir()
+	path, err := Install(context.Background(), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != installPath(dir) {
+		t.Errorf("installed at %s, want %s", path, installPath(dir))
+	}
+	assertInstalled(t, path)
+
+	installed, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Install(context.Background(), dir); err != nil {
+		t.Fatal(err)
+	}
+	again, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !os.SameFile(installed, again) {
+		t.Error("matching file replaced instead of reused")
+	}
+}
+
+func TestInstallReplacesDamagedFile(t *testing.T) {
+	damage := map[string]func(path string) error{
+		"truncated": func(path string) error {
+			return os.Truncate(path, 100)
+		},
+		"tampered": func(path string) error {
+			data := AlerterBinary()
+			data[len(data)/2] ^= 0xff
+			return ioutil.WriteFile(path, data, 0755)
+		},
+		"empty": func(path string) error {
+			return ioutil.WriteFile(path, nil, 0755)
+		},
+	}
+	for name, damageFile := range damage {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			path, err := Install(context.Background(), dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := damageFile(path); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Install(context.Background(), dir); err != nil {
+				t.Fatal(err)
+			}
+			assertInstalled(t, path)
+		})
+	}
+}
+
+func TestInstallCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	dir := t.TempDir()
+	if _, err := Install(ctx, dir); err != context.Canceled {
+		t.Fatalf("error = %v, want context.Canceled", err)
+	}
+	if _, err := os.Stat(installPath(dir)); !os.IsNotExist(err) {
+		t.Errorf("alerter installed despite the canceled context: %v", err)
+	}
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "alerter")
+	if err := ioutil.WriteFile(path, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	old, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer old.Close()
+
+	if err := writeFileAtomic(path, []byte("new"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	// The file is replaced by a rename: readers of the old file still see
+	// it whole.
+	data, err := ioutil.ReadAll(old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "old" {
+		t.Errorf("old file reads %q, want old", data)
+	}
+	data, err = ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "new" {
+		t.Errorf("file reads %q, want new", data)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0755 {
+		t.Errorf("file mode %s, want 0755", info.Mode().Perm())
+	}
+
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("%d files in the directory, want no temporary file left", len(entries))
+	}
+}
+
+func TestWriteFileAtomicMissingDir(t *testing.T) {
+	dir := t.TempDir()
+	if err := writeFileAtomic(filepath.Join(dir, "missing", "alerter"), []byte("new"), 0755); err == nil {
+		t.Fatal("written into a missing directory")
+	}
+}
+
+// assertInstalled checks that path holds the embedded alerter binary, as
+// an executable.
+func assertInstalled(t *testing.T, path string) {
+	t.Helper()
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, alerterBinary) {
+		t.Errorf("%s differs from the embedded binary", path)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm()&0111 == 0 {
+		t.Errorf("%s is not executable: %s", path, info.Mode())
+	}
+}
@@ -0,0 +1,79 @@
+// Command genalerter builds the alerter binary embedded by gosxalerter,
+// and writes it along with its SHA-256 digest.
+//
+//	go run ./internal/genalerter -project /path/to/alerter -o assets/alerter
+//	go run ./internal/genalerter -src /path/to/built/alerter -o assets/alerter
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+func main() {
+	project := flag.String("project", "", "alerter Xcode project checkout to build")
+	src := flag.String("src", "", "already built alerter binary, instead of -project")
+	out := flag.String("o", "assets/alerter", "output file")
+	flag.Parse()
+
+	log.SetFlags(0)
+	log.SetPrefix("genalerter: ")
+
+	binary := *src
+	if binary == "" {
+		if *project == "" {
+			log.Fatalln("-project or -src is required, set ALERTER_SRC when running go generate")
+		}
+		var err error
+		binary, err = build(*project)
+		if err != nil {
+			log.Fatalln(err)
+		}
+	}
+
+	data, err := ioutil.ReadFile(binary)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if err := ioutil.WriteFile(*out, data, 0755); err != nil {
+		log.Fatalln(err)
+	}
+
+	sum := sha256.Sum256(data)
+	digest := fmt.Sprintf("%s  %s\n", hex.EncodeToString(sum[:]), filepath.Base(*out))
+	if err := ioutil.WriteFile(*out+".sha256", []byte(digest), 0644); err != nil {
+		log.Fatalln(err)
+	}
+	fmt.Print(digest)
+}
+
+// build builds the Release configuration of the alerter project with
+// xcodebuild, and returns the path of the product.
+func build(project string) (string, error) {
+	derived, err := ioutil.TempDir("", "genalerter")
+	if err != nil {
+		return "", err
+	}
+
+	cmd := exec.Command("xcodebuild",
+		"-project", filepath.Join(project, "alerter.xcodeproj"),
+		"-scheme", "alerter",
+		"-configuration", "Release",
+		"-derivedDataPath", derived,
+		"build",
+	)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("xcodebuild: %w", err)
+	}
+
+	return filepath.Join(derived, "Build", "Products", "Release", "alerter"), nil
+}
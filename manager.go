@@ -0,0 +1,295 @@
+package gosxalerter
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrManagerShutdown is returned when delivering through a Manager which
+// is shutting down.
+var ErrManagerShutdown = errors.New("alert manager is shut down")
+
+// Manager keeps track of the alerts delivered through it, so they can be
+// listed and closed together. When MaxConcurrent alerts are displayed,
+// the next ones are queued until a displayed alert is activated.
+type Manager struct {
+	MaxConcurrent int // Maximum number of alerts displayed at once, 0 for no limit
+
+	mu        sync.Mutex
+	displayed []*managedAlert
+	queue     []*managedAlert
+	shutdown  bool
+	idle      chan struct{}
+}
+
+type managedAlert struct {
+	alert      *Alert
+	ctx        context.Context
+	activation chan *Activation
+	stopWatch  func() bool
+
+	// Guarded by Manager.mu. An alert closed before start delivered it is
+	// closed by start.
+	started        bool
+	closeRequested bool
+}
+
+// NewManager returns a Manager displaying at most maxConcurrent alerts at
+// once, 0 for no limit.
+func NewManager(maxConcurrent int) *Manager {
+	return &Manager{
+		MaxConcurrent: maxConcurrent,
+	}
+}
+
+// Deliver displays the alert, or queues it when MaxConcurrent alerts are
+// displayed, and returns a chan that will be feeded with its Activation.
+// The alert is closed when ctx is done, as with Alert.DeliverContext.
+func (m *Manager) Deliver(ctx context.Context, a *Alert) (<-chan *Activation, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	e := &managedAlert{
+		alert:      a,
+		ctx:        ctx,
+		activation: make(chan *Activation, 1),
+	}
+
+	m.mu.Lock()
+	if m.shutdown {
+		m.mu.Unlock()
+		return nil, ErrManagerShutdown
+	}
+	if m.MaxConcurrent > 0 && len(m.displayed) >= m.MaxConcurrent {
+		m.queue = append(m.queue, e)
+		e.stopWatch = context.AfterFunc(ctx, func() { m.dropQueued(e) })
+		m.mu.Unlock()
+		return e.activation, nil
+	}
+	m.displayed = append(m.displayed, e)
+	m.mu.Unlock()
+
+	if err := m.start(e); err != nil {
+		m.mu.Lock()
+		m.displayed = removeManaged(m.displayed, e)
+		m.mu.Unlock()
+		m.next()
+		return nil, err
+	}
+	return e.activation, nil
+}
+
+// DeliverAndWait displays the alert through the manager and returns its
+// Activation, as Alert.DeliverAndWaitContext does.
+func (m *Manager) DeliverAndWait(ctx context.Context, a *Alert) (*Activation, error) {
+	activationChan, err := m.Deliver(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	activation := <-activationChan
+	switch activation.Type {
+	case ActivationTypeCanceled:
+		return activation, ctx.Err()
+	case ActivationTypeFailed:
+		return activation, activation.Err
+	}
+	return activation, nil
+}
+
+// Replace closes the displayed and queued alerts of the group of a, then
+// delivers a as Deliver does. An alert without group replaces nothing. a
+// is delivered even when an alert of its group fails to close, it then
+// stays displayed.
+func (m *Manager) Replace(ctx context.Context, a *Alert) (<-chan *Activation, error) {
+	if group := a.Options.Group; group != "" {
+		m.CloseGroup(group)
+	}
+	return m.Deliver(ctx, a)
+}
+
+// List returns the displayed alerts followed by the queued ones.
+func (m *Manager) List() []*Alert {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	alerts := make([]*Alert, 0, len(m.displayed)+len(m.queue))
+	for _, e := range m.displayed {
+		alerts = append(alerts, e.alert)
+	}
+	for _, e := range m.queue {
+		alerts = append(alerts, e.alert)
+	}
+	return alerts
+}
+
+// CloseAll closes every displayed alert and drops the queued ones, which
+// are activated with ActivationTypeClosed. Alerts activated meanwhile are
+// not an error.
+func (m *Manager) CloseAll() error {
+	return m.closeMatching(func(*Alert) bool { return true })
+}
+
+// CloseGroup closes the displayed and queued alerts of a group.
+func (m *Manager) CloseGroup(group string) error {
+	return m.closeMatching(func(a *Alert) bool { return a.Options.Group == group })
+}
+
+// Shutdown stops accepting alerts and waits for every displayed and
+// queued alert to be activated. When ctx is done first, the remaining
+// alerts are closed without waiting for their activations, and ctx.Err()
+// is returned, joined with the errors of closing them.
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.mu.Lock()
+	if !m.shutdown {
+		m.shutdown = true
+		m.idle = make(chan struct{})
+		if len(m.displayed) == 0 && len(m.queue) == 0 {
+			close(m.idle)
+		}
+	}
+	idle := m.idle
+	m.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return errors.Join(ctx.Err(), m.CloseAll())
+	}
+}
+
+func (m *Manager) closeMatching(match func(*Alert) bool) error {
+	m.mu.Lock()
+	var dropped []*managedAlert
+	queue := m.queue[:0]
+	for _, e := range m.queue {
+		if match(e.alert) {
+			dropped = append(dropped, e)
+		} else {
+			queue = append(queue, e)
+		}
+	}
+	m.queue = queue
+	var closing []*Alert
+	for _, e := range m.displayed {
+		switch {
+		case !match(e.alert):
+		case !e.started:
+			e.closeRequested = true
+		default:
+			closing = append(closing, e.alert)
+		}
+	}
+	m.checkIdle()
+	m.mu.Unlock()
+
+	for _, e := range dropped {
+		e.stopWatch()
+		e.activation <- &Activation{Type: ActivationTypeClosed}
+		close(e.activation)
+	}
+
+	var errs []error
+	for _, a := range closing {
+		if err := a.Close(); err != nil && a.running() {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// start delivers a displayed alert and forwards its activation.
+func (m *Manager) start(e *managedAlert) error {
+	activationChan, err := e.alert.DeliverContext(e.ctx)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	e.started = true
+	closeRequested := e.closeRequested
+	m.mu.Unlock()
+	if closeRequested {
+		e.alert.Close()
+	}
+
+	go func() {
+		e.activation <- <-activationChan
+		close(e.activation)
+
+		m.mu.Lock()
+		m.displayed = removeManaged(m.displayed, e)
+		m.mu.Unlock()
+		m.next()
+	}()
+	return nil
+}
+
+// next displays queued alerts while there is room for them.
+func (m *Manager) next() {
+	for {
+		m.mu.Lock()
+		if len(m.queue) == 0 || (m.MaxConcurrent > 0 && len(m.displayed) >= m.MaxConcurrent) {
+			m.checkIdle()
+			m.mu.Unlock()
+			return
+		}
+		e := m.queue[0]
+		m.queue = m.queue[1:]
+		m.displayed = append(m.displayed, e)
+		e.stopWatch()
+		m.mu.Unlock()
+
+		if err := m.start(e); err != nil {
+			m.mu.Lock()
+			m.displayed = removeManaged(m.displayed, e)
+			m.mu.Unlock()
+			e.activation <- &Activation{Type: ActivationTypeFailed, Err: err}
+			close(e.activation)
+		}
+	}
+}
+
+// dropQueued drops a queued alert whose context is done.
+func (m *Manager) dropQueued(e *managedAlert) {
+	m.mu.Lock()
+	queued := false
+	for _, q := range m.queue {
+		if q == e {
+			queued = true
+		}
+	}
+	if queued {
+		m.queue = removeManaged(m.queue, e)
+		m.checkIdle()
+	}
+	m.mu.Unlock()
+
+	if queued {
+		e.activation <- &Activation{Type: ActivationTypeCanceled}
+		close(e.activation)
+	}
+}
+
+// checkIdle signals Shutdown once every alert is activated, m.mu must be
+// held.
+func (m *Manager) checkIdle() {
+	if !m.shutdown || len(m.displayed) > 0 || len(m.queue) > 0 {
+		return
+	}
+	select {
+	case <-m.idle:
+	default:
+		close(m.idle)
+	}
+}
+
+func removeManaged(list []*managedAlert, e *managedAlert) []*managedAlert {
+	for i, x := range list {
+		if x == e {
+			return append(list[:i:i], list[i+1:]...)
+		}
+	}
+	return list
+}
@@ -0,0 +1,123 @@
+package gosxalerter_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+	"github.com/vjeantet/gosx-alerter/gosxalertertest"
+)
+
+// stuckBackend delivers notifications which are never activated, even
+// when closed.
+type stuckBackend struct{}
+
+type stuckNotification struct{}
+
+func (stuckBackend) Deliver(*gosxalerter.Options) (gosxalerter.Notification, error) {
+	return stuckNotification{}, nil
+}
+
+func (stuckBackend) Remove(string) error { return nil }
+
+func (stuckNotification) Activations() <-chan *gosxalerter.Activation { return nil }
+
+func (stuckNotification) Close() error { return nil }
+
+func TestManagerCloseActivatedAlerts(t *testing.T) {
+	backend := gosxalertertest.New()
+	m := gosxalerter.NewManager(0)
+
+	for i := 0; i < 100; i++ {
+		backend.ClickContents()
+		a, err := gosxalerter.New("hello", gosxalerter.WithBackend(backend), gosxalerter.WithGroup("g"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		activationChan, err := m.Deliver(context.Background(), a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := m.CloseGroup("g"); err != nil {
+			t.Fatalf("close %d: %v", i, err)
+		}
+		<-activationChan
+	}
+}
+
+func TestManagerCloseQueuedAlerts(t *testing.T) {
+	backend := gosxalertertest.New()
+	m := gosxalerter.NewManager(1)
+
+	var chans []<-chan *gosxalerter.Activation
+	for i := 0; i < 3; i++ {
+		a, err := gosxalerter.New("hello", gosxalerter.WithBackend(backend))
+		if err != nil {
+			t.Fatal(err)
+		}
+		activationChan, err := m.Deliver(context.Background(), a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		chans = append(chans, activationChan)
+	}
+	if err := m.CloseAll(); err != nil {
+		t.Fatal(err)
+	}
+	for i, activationChan := range chans {
+		if act := <-activationChan; act.Type != gosxalerter.ActivationTypeClosed {
+			t.Errorf("alert %d activated with %q, want closed", i, act.Type)
+		}
+	}
+	if n := len(backend.Delivered()); n != 1 {
+		t.Errorf("delivered %d alerts, want only the displayed one", n)
+	}
+	if n := backend.Displayed(); n != 0 {
+		t.Errorf("%d alerts still displayed", n)
+	}
+}
+
+func TestManagerShutdownDeadline(t *testing.T) {
+	m := gosxalerter.NewManager(0)
+	a, err := gosxalerter.New("hello", gosxalerter.WithBackend(stuckBackend{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Deliver(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() { done <- m.Shutdown(ctx) }()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("error = %v, want context.DeadlineExceeded", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Shutdown blocked after its context was done")
+	}
+}
+
+func TestManagerReplace(t *testing.T) {
+	backend := gosxalertertest.New()
+	m := gosxalerter.NewManager(0)
+
+	for i := 0; i < 50; i++ {
+		backend.ClickContents()
+		a, err := gosxalerter.New("build failed", gosxalerter.WithBackend(backend), gosxalerter.WithGroup("ci"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := m.Replace(context.Background(), a); err != nil {
+			t.Fatalf("replace %d: %v", i, err)
+		}
+	}
+	if n := len(backend.Delivered()); n != 50 {
+		t.Errorf("delivered %d alerts, want 50", n)
+	}
+}
@@ -0,0 +1,223 @@
+package gosxalerter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Option configures an Alert built by New or Derive.
+type Option func(a *Alert)
+
+// WithMessage sets the message, to override the one of a template alert.
+func WithMessage(message string) Option {
+	return func(a *Alert) { a.Options.Message = message }
+}
+
+// WithTitle sets the title, which defaults to the program name.
+func WithTitle(title string) Option {
+	return func(a *Alert) { a.Options.Title = title }
+}
+
+// WithSubtitle sets the text under the title.
+func WithSubtitle(subtitle string) Option {
+	return func(a *Alert) { a.Options.Subtitle = subtitle }
+}
+
+// WithSound sets the sound played when the alert pops up.
+func WithSound(sound Sound) Option {
+	return func(a *Alert) { a.Options.Sound = sound }
+}
+
+// WithSender sends the alert as a known OSX app, by bundle identifier.
+func WithSender(bundleID string) Option {
+	return func(a *Alert) { a.Options.Sender = bundleID }
+}
+
+// WithGroup sets the group ID, an alert replaces the displayed alert of
+// the same group.
+func WithGroup(group string) Option {
+	return func(a *Alert) { a.Options.Group = group }
+}
+
+// WithAppIcon sets the path or URL of the app icon.
+func WithAppIcon(image string) Option {
+	return func(a *Alert) { a.Options.AppIcon = image }
+}
+
+// WithContentImage sets the path or URL of the image attached to the alert.
+func WithContentImage(image string) Option {
+	return func(a *Alert) { a.Options.ContentImage = image }
+}
+
+// WithUncheckedImages skips checking that the AppIcon and ContentImage
+// given as local paths are readable, such as for options received from
+// another machine, which must not learn which files exist.
+func WithUncheckedImages() Option {
+	return func(a *Alert) { a.uncheckedImages = true }
+}
+
+// WithActions sets the actions available on the alert.
+func WithActions(actions ...string) Option {
+	return func(a *Alert) { a.Options.Actions = append([]string(nil), actions...) }
+}
+
+// WithReply makes a reply alert, placeholder is shown in the empty reply
+// field, "Reply" when empty.
+func WithReply(placeholder string) Option {
+	return func(a *Alert) {
+		a.Options.Reply = true
+		if placeholder != "" {
+			a.Options.ReplyPlaceHolder = placeholder
+		}
+	}
+}
+
+// WithCloseLabel changes the Close button label.
+func WithCloseLabel(label string) Option {
+	return func(a *Alert) { a.Options.CloseLabel = label }
+}
+
+// WithDropdownLabel sets the label of the actions dropdown, shown when
+// there is more than one action.
+func WithDropdownLabel(label string) Option {
+	return func(a *Alert) { a.Options.DropdownLabel = label }
+}
+
+// WithTimeout closes the alert automatically after d.
+func WithTimeout(d time.Duration) Option {
+	return func(a *Alert) { a.Options.Timeout = d }
+}
+
+// WithBackend delivers the alert through backend instead of
+// DefaultBackend.
+func WithBackend(backend Backend) Option {
+	return func(a *Alert) { a.Backend = backend }
+}
+
+// TimeoutIn returns Timeout as a number of units, for backends taking
+// a timeout in seconds or milliseconds. Timeout is rounded up to the
+// unit, so that an alert is never closed before Timeout; 0 means no
+// timeout.
+func (o *Options) TimeoutIn(unit time.Duration) int64 {
+	if o.Timeout <= 0 {
+		return 0
+	}
+	return int64((o.Timeout + unit - 1) / unit)
+}
+
+// Clone returns a copy of the options, which does not share Actions.
+func (o *Options) Clone() *Options {
+	c := *o
+	c.Actions = append([]string(nil), o.Actions...)
+	return &c
+}
+
+// Derive returns a new alert with the options and backend of a, modified
+// by opts. The returned alert has its own ID and lifecycle, a is left
+// untouched.
+func (a *Alert) Derive(opts ...Option) (*Alert, error) {
+	d := &Alert{
+		ID:      newAlertID(),
+		Options: a.Options.Clone(),
+		Backend: a.Backend,
+
+		uncheckedImages: a.uncheckedImages,
+	}
+	if err := d.apply(opts); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// apply configures the alert with opts, then validates its options.
+func (a *Alert) apply(opts []Option) error {
+	for _, opt := range opts {
+		opt(a)
+	}
+	err := errors.Join(a.optionsErr, a.Options.validateFor(a.backend(), !a.uncheckedImages))
+	a.optionsErr = nil
+	return err
+}
+
+type optionsAlias Options
+
+// MarshalJSON encodes Timeout as a duration string, such as "1m30s".
+func (o Options) MarshalJSON() ([]byte, error) {
+	aux := struct {
+		optionsAlias
+		Timeout string `json:"timeout,omitempty"`
+	}{optionsAlias: optionsAlias(o)}
+	if o.Timeout != 0 {
+		aux.Timeout = o.Timeout.String()
+	}
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON decodes Timeout from a duration string, such as "1m30s",
+// or from a number of seconds.
+func (o *Options) UnmarshalJSON(data []byte) error {
+	aux := struct {
+		*optionsAlias
+		Timeout json.RawMessage `json:"timeout"`
+	}{optionsAlias: (*optionsAlias)(o)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	raw := strings.TrimSpace(string(aux.Timeout))
+	switch {
+	case raw == "" || raw == "null":
+	case strings.HasPrefix(raw, `"`):
+		var s string
+		if err := json.Unmarshal(aux.Timeout, &s); err != nil {
+			return err
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("timeout: %w", err)
+		}
+		o.Timeout = d
+	default:
+		var seconds float64
+		if err := json.Unmarshal(aux.Timeout, &seconds); err != nil {
+			return fmt.Errorf("timeout: %w", err)
+		}
+		o.Timeout = time.Duration(seconds * float64(time.Second))
+	}
+	return nil
+}
+
+// UnmarshalYAML decodes the options as UnmarshalJSON does, so Timeout is a
+// duration string or a number of seconds.
+func (o *Options) UnmarshalYAML(value *yaml.Node) error {
+	var fields map[string]interface{}
+	if err := value.Decode(&fields); err != nil {
+		return err
+	}
+	return o.unmarshalFields(fields)
+}
+
+// UnmarshalTOML decodes the options as UnmarshalJSON does, so Timeout is a
+// duration string or a number of seconds.
+func (o *Options) UnmarshalTOML(data interface{}) error {
+	fields, ok := data.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("options must be a table, got %T", data)
+	}
+	return o.unmarshalFields(fields)
+}
+
+// unmarshalFields decodes the fields of a YAML or TOML document through
+// UnmarshalJSON.
+func (o *Options) unmarshalFields(fields map[string]interface{}) error {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return o.UnmarshalJSON(data)
+}
@@ -0,0 +1,122 @@
+package gosxalerter_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+	"github.com/vjeantet/gosx-alerter/gosxalertertest"
+)
+
+func TestTimeoutIn(t *testing.T) {
+	tests := []struct {
+		timeout      time.Duration
+		seconds      int64
+		milliseconds int64
+	}{
+		{0, 0, 0},
+		{-time.Second, 0, 0},
+		{time.Nanosecond, 1, 1},
+		{time.Millisecond, 1, 1},
+		{time.Second, 1, 1000},
+		{1500 * time.Millisecond, 2, 1500},
+		{1500*time.Millisecond + time.Nanosecond, 2, 1501},
+	}
+	for _, tt := range tests {
+		o := &gosxalerter.Options{Timeout: tt.timeout}
+		if got := o.TimeoutIn(time.Second); got != tt.seconds {
+			t.Errorf("%s in seconds: %d, want %d", tt.timeout, got, tt.seconds)
+		}
+		if got := o.TimeoutIn(time.Millisecond); got != tt.milliseconds {
+			t.Errorf("%s in milliseconds: %d, want %d", tt.timeout, got, tt.milliseconds)
+		}
+	}
+}
+
+func TestDerive(t *testing.T) {
+	backend := gosxalertertest.New()
+	tmpl, err := gosxalerter.New("Deploy ?",
+		gosxalerter.WithBackend(backend),
+		gosxalerter.WithTitle("Deploy"),
+		gosxalerter.WithActions("Yes", "No"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := tmpl.Derive(gosxalerter.WithMessage("Rollback ?"), gosxalerter.WithActions("Rollback"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.ID == "" || d.ID == tmpl.ID {
+		t.Errorf("derived ID %q, want a fresh ID", d.ID)
+	}
+	if d.Backend != tmpl.Backend || d.Options.Title != "Deploy" {
+		t.Errorf("derived backend %v, title %q, want those of the template", d.Backend, d.Options.Title)
+	}
+	if d.Options.Message != "Rollback ?" || !reflect.DeepEqual(d.Options.Actions, []string{"Rollback"}) {
+		t.Errorf("derived options %+v", d.Options)
+	}
+	d.Options.Actions[0] = "changed"
+	if tmpl.Options.Message != "Deploy ?" || !reflect.DeepEqual(tmpl.Options.Actions, []string{"Yes", "No"}) {
+		t.Errorf("template options changed to %+v", tmpl.Options)
+	}
+
+	// Deriving does not share the Actions array of the template.
+	same, err := tmpl.Derive()
+	if err != nil {
+		t.Fatal(err)
+	}
+	same.Options.Actions[0] = "changed"
+	if tmpl.Options.Actions[0] != "Yes" {
+		t.Errorf("template actions changed to %q", tmpl.Options.Actions)
+	}
+
+	var fieldErr *gosxalerter.FieldError
+	if _, err := tmpl.Derive(gosxalerter.WithMessage("")); !errors.As(err, &fieldErr) || fieldErr.Field != "Message" {
+		t.Errorf("Derive error = %v, want a Message *FieldError", err)
+	}
+}
+
+func TestNewValidates(t *testing.T) {
+	backend := gosxalertertest.New()
+	for name, opts := range map[string][]gosxalerter.Option{
+		"empty message":    {gosxalerter.WithMessage("")},
+		"negative timeout": {gosxalerter.WithTimeout(-time.Second)},
+		"reply actions":    {gosxalerter.WithReply(""), gosxalerter.WithActions("Yes")},
+		"bad template":     {gosxalerter.WithMessage("{{.Missing}}"), gosxalerter.WithTemplate(map[string]string{})},
+	} {
+		a, err := gosxalerter.New("hello", append(opts, gosxalerter.WithBackend(backend))...)
+		var fieldErr *gosxalerter.FieldError
+		if a != nil || !errors.As(err, &fieldErr) {
+			t.Errorf("%s: New returned %v, %v, want a *FieldError", name, a, err)
+		}
+	}
+	if n := len(backend.Delivered()); n != 0 {
+		t.Errorf("delivered %d alerts, want none", n)
+	}
+}
+
+func TestWithReply(t *testing.T) {
+	a, err := gosxalerter.New("Version ?", gosxalerter.WithReply(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !a.Options.Reply || a.Options.ReplyPlaceHolder != "Reply" {
+		t.Errorf("reply %t, placeholder %q, want the Reply placeholder", a.Options.Reply, a.Options.ReplyPlaceHolder)
+	}
+
+	a, err = gosxalerter.New("Version ?", gosxalerter.WithReply("v1.2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := a.Derive(gosxalerter.WithReply(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Options.ReplyPlaceHolder != "v1.2" {
+		t.Errorf("derived placeholder %q, want v1.2 kept", d.Options.ReplyPlaceHolder)
+	}
+}
@@ -0,0 +1,247 @@
+package gosxalerter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MaxLineSize is the longest line FromReader matches, longer lines are
+// cut to it.
+const MaxLineSize = 1 << 20
+
+// Matcher picks the lines read by FromReader which raise an alert.
+type Matcher interface {
+	// Match returns the options of the alert raised by line, or false
+	// when line raises no alert.
+	Match(line string) (*Options, bool)
+}
+
+// MatcherFunc is a function used as a Matcher.
+type MatcherFunc func(line string) (*Options, bool)
+
+// Match calls f(line).
+func (f MatcherFunc) Match(line string) (*Options, bool) {
+	return f(line)
+}
+
+// MatchRegexp returns a Matcher raising an alert for every line matching
+// re. The alert has the options of tmpl, whose Title, Subtitle, Message
+// and Actions are rendered as Options.Render does, with the line as .Line and
+// the named submatches of re. The message is the line when tmpl is nil or
+// has no message.
+func MatchRegexp(re *regexp.Regexp, tmpl *Options) Matcher {
+	if tmpl == nil {
+		tmpl = &Options{}
+	}
+	return MatcherFunc(func(line string) (*Options, bool) {
+		m := re.FindStringSubmatch(line)
+		if m == nil {
+			return nil, false
+		}
+		vars := map[string]string{"Line": line}
+		for i, name := range re.SubexpNames() {
+			if name != "" {
+				vars[name] = m[i]
+			}
+		}
+		o, err := tmpl.Render(vars)
+		if err != nil {
+			return nil, false
+		}
+		if o.Message == "" {
+			o.Message = line
+		}
+		return o, true
+	})
+}
+
+// MatchJSON returns a Matcher raising an alert for every line holding a
+// JSON object with a message, such as
+//
+//	{"title": "Build", "message": "3 tests failed", "group": "ci"}
+//
+// The fields of the object, named as the JSON encoding of Options, override
+// the options of tmpl.
+func MatchJSON(tmpl *Options) Matcher {
+	if tmpl == nil {
+		tmpl = &Options{}
+	}
+	return MatcherFunc(func(line string) (*Options, bool) {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "{") {
+			return nil, false
+		}
+		o := tmpl.Clone()
+		if err := json.Unmarshal([]byte(line), o); err != nil || o.Message == "" {
+			return nil, false
+		}
+		return o, true
+	})
+}
+
+// FromReader reads r line by line, and sends on alerts an alert for every
+// line m matches. Lines raising invalid options are skipped, lines longer
+// than MaxLineSize are cut. alerts is
+// closed once r is read, errc then receives the read error, nil at the end
+// of r. errc is buffered, receiving from it is optional.
+func FromReader(r io.Reader, m Matcher) (alerts <-chan *Alert, errc <-chan error) {
+	out := make(chan *Alert)
+	errOut := make(chan error, 1)
+
+	go func() {
+		defer close(errOut)
+		defer close(out)
+
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), MaxLineSize)
+		scanner.Split(scanCutLines())
+		for scanner.Scan() {
+			o, ok := m.Match(scanner.Text())
+			if !ok {
+				continue
+			}
+			a, err := NewWithOptions(o)
+			if err != nil {
+				continue
+			}
+			out <- a
+		}
+		errOut <- scanner.Err()
+	}()
+
+	return out, errOut
+}
+
+// scanCutLines returns a bufio.ScanLines splitting lines longer than
+// MaxLineSize after MaxLineSize bytes, and dropping the rest of the line.
+func scanCutLines() bufio.SplitFunc {
+	cutting := false
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		if cutting {
+			i := bytes.IndexByte(data, '\n')
+			if i < 0 {
+				return len(data), nil, nil
+			}
+			cutting = false
+			return i + 1, nil, nil
+		}
+		advance, token, err := bufio.ScanLines(data, atEOF)
+		if advance == 0 && err == nil && len(data) >= MaxLineSize {
+			cutting = true
+			return len(data), data, nil
+		}
+		return advance, token, err
+	}
+}
+
+// Coalesce forwards the alerts received from in, coalescing bursts: the
+// alerts of a group, as set by Options.Group, received within window of
+// the first one are sent as a single alert built by summary when there are
+// several of them. Alerts are then held for window before being sent, a
+// zero window forwards them right away. A nil summary is
+// CountSummary("alerts"). The returned chan is closed once in is closed
+// and the pending alerts are sent.
+func Coalesce(in <-chan *Alert, window time.Duration, summary func([]*Alert) *Alert) <-chan *Alert {
+	if summary == nil {
+		summary = CountSummary("alerts")
+	}
+	out := make(chan *Alert)
+
+	go func() {
+		defer close(out)
+
+		type burst struct {
+			alerts   []*Alert
+			deadline time.Time
+		}
+		bursts := make(map[string]*burst)
+		flush := func(group string) {
+			b := bursts[group]
+			delete(bursts, group)
+			if len(b.alerts) == 1 {
+				out <- b.alerts[0]
+			} else {
+				out <- summary(b.alerts)
+			}
+		}
+		// due returns the groups whose burst ends before t, earliest first.
+		due := func(t time.Time) []string {
+			var groups []string
+			for group, b := range bursts {
+				if !b.deadline.After(t) {
+					groups = append(groups, group)
+				}
+			}
+			sort.Slice(groups, func(i, j int) bool {
+				return bursts[groups[i]].deadline.Before(bursts[groups[j]].deadline)
+			})
+			return groups
+		}
+
+		for {
+			var timer <-chan time.Time
+			if len(bursts) > 0 {
+				next := time.Time{}
+				for _, b := range bursts {
+					if next.IsZero() || b.deadline.Before(next) {
+						next = b.deadline
+					}
+				}
+				timer = time.After(time.Until(next))
+			}
+
+			select {
+			case a, ok := <-in:
+				if !ok {
+					for _, group := range due(time.Now().Add(window)) {
+						flush(group)
+					}
+					return
+				}
+				if window <= 0 {
+					out <- a
+					continue
+				}
+				group := a.Options.Group
+				if b, ok := bursts[group]; ok {
+					b.alerts = append(b.alerts, a)
+				} else {
+					bursts[group] = &burst{
+						alerts:   []*Alert{a},
+						deadline: time.Now().Add(window),
+					}
+				}
+			case now := <-timer:
+				for _, group := range due(now) {
+					flush(group)
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// CountSummary returns a summary for Coalesce, reading "12 new errors"
+// with noun "errors". The summary has the options of the last alert of the
+// burst, with its message as subtitle.
+func CountSummary(noun string) func([]*Alert) *Alert {
+	return func(alerts []*Alert) *Alert {
+		last := alerts[len(alerts)-1]
+		s, err := last.Derive(
+			WithMessage(fmt.Sprintf("%d new %s", len(alerts), noun)),
+			WithSubtitle(last.Options.Message),
+		)
+		if err != nil {
+			return last
+		}
+		return s
+	}
+}
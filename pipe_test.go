@@ -0,0 +1,167 @@
+package gosxalerter_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+)
+
+func TestFromReaderLongLines(t *testing.T) {
+	long := strings.Repeat("x", 3*gosxalerter.MaxLineSize)
+	input := "first\n" + long + "\nlast\n"
+	m := gosxalerter.MatcherFunc(func(line string) (*gosxalerter.Options, bool) {
+		return &gosxalerter.Options{Message: line}, true
+	})
+
+	alerts, errc := gosxalerter.FromReader(strings.NewReader(input), m)
+	var messages []string
+	for a := range alerts {
+		messages = append(messages, a.Options.Message)
+	}
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+
+	if len(messages) != 3 {
+		t.Fatalf("got %d alerts, want 3", len(messages))
+	}
+	if messages[0] != "first" || messages[2] != "last" {
+		t.Errorf("got %q and %q around the long line", messages[0], messages[2])
+	}
+	if len(messages[1]) != gosxalerter.MaxLineSize {
+		t.Errorf("long line cut to %d bytes, want %d", len(messages[1]), gosxalerter.MaxLineSize)
+	}
+}
+
+func TestMatchRegexp(t *testing.T) {
+	re := regexp.MustCompile(`^(?P<file>\S+):(?P<line>\d+): error: (?P<text>.*)$`)
+	m := gosxalerter.MatchRegexp(re, &gosxalerter.Options{
+		Title:   "{{.file}}:{{.line}}",
+		Message: "{{.text}}",
+		Group:   "build",
+	})
+
+	o, ok := m.Match("main.go:12: error: undefined: x")
+	if !ok {
+		t.Fatal("matching line ignored")
+	}
+	if o.Title != "main.go:12" || o.Message != "undefined: x" || o.Group != "build" {
+		t.Errorf("options %q %q %q", o.Title, o.Message, o.Group)
+	}
+	if _, ok := m.Match("main.go:12: warning: unused"); ok {
+		t.Error("line not matching the regexp raised an alert")
+	}
+
+	o, ok = gosxalerter.MatchRegexp(re, nil).Match("a.go:1: error: x")
+	if !ok || o.Message != "a.go:1: error: x" {
+		t.Errorf("options %+v, want the line as message without template", o)
+	}
+}
+
+func TestMatchJSON(t *testing.T) {
+	tmpl := &gosxalerter.Options{Title: "CI", Group: "default", Sound: gosxalerter.SoundBasso}
+	m := gosxalerter.MatchJSON(tmpl)
+
+	o, ok := m.Match(`  {"message": "3 tests failed", "group": "ci", "actions": ["Open"]}`)
+	if !ok {
+		t.Fatal("JSON line ignored")
+	}
+	if o.Message != "3 tests failed" || o.Group != "ci" || len(o.Actions) != 1 {
+		t.Errorf("options %+v, want the fields of the line", o)
+	}
+	if o.Title != "CI" || o.Sound != gosxalerter.SoundBasso {
+		t.Errorf("title %q, sound %q, want those of the template", o.Title, o.Sound)
+	}
+	if tmpl.Group != "default" {
+		t.Errorf("template group changed to %q", tmpl.Group)
+	}
+
+	for _, line := range []string{"3 tests failed", `{"title": "no message"}`, `{"message": `} {
+		if _, ok := m.Match(line); ok {
+			t.Errorf("line %q raised an alert", line)
+		}
+	}
+}
+
+// burst returns alerts of group, with messages.
+func burst(t *testing.T, group string, messages ...string) []*gosxalerter.Alert {
+	t.Helper()
+	var alerts []*gosxalerter.Alert
+	for _, message := range messages {
+		a, err := gosxalerter.New(message, gosxalerter.WithGroup(group))
+		if err != nil {
+			t.Fatal(err)
+		}
+		alerts = append(alerts, a)
+	}
+	return alerts
+}
+
+// receive returns the next alert sent on out, nil once out is closed.
+func receive(t *testing.T, out <-chan *gosxalerter.Alert) *gosxalerter.Alert {
+	t.Helper()
+	select {
+	case a := <-out:
+		return a
+	case <-time.After(time.Second):
+		t.Fatal("no alert")
+		return nil
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	in := make(chan *gosxalerter.Alert)
+	out := gosxalerter.Coalesce(in, 50*time.Millisecond, gosxalerter.CountSummary("errors"))
+
+	for _, a := range append(burst(t, "build", "e1", "e2", "e3"), burst(t, "lint", "w1")...) {
+		in <- a
+	}
+	got := map[string]*gosxalerter.Options{}
+	for i := 0; i < 2; i++ {
+		a := receive(t, out)
+		got[a.Options.Group] = a.Options
+	}
+	if o := got["build"]; o == nil || o.Message != "3 new errors" || o.Subtitle != "e3" {
+		t.Errorf("build burst coalesced as %+v, want 3 new errors", o)
+	}
+	if o := got["lint"]; o == nil || o.Message != "w1" {
+		t.Errorf("single lint alert forwarded as %+v, want w1", o)
+	}
+
+	close(in)
+	if a := receive(t, out); a != nil {
+		t.Errorf("alert %q after the input closed", a.Options.Message)
+	}
+}
+
+func TestCoalesceFlushOnClose(t *testing.T) {
+	in := make(chan *gosxalerter.Alert)
+	out := gosxalerter.Coalesce(in, time.Hour, nil)
+
+	for _, a := range burst(t, "", "e1", "e2") {
+		in <- a
+	}
+	close(in)
+	if a := receive(t, out); a == nil || a.Options.Message != "2 new alerts" {
+		t.Fatalf("pending burst flushed as %v, want 2 new alerts", a)
+	}
+	if a := receive(t, out); a != nil {
+		t.Errorf("alert %q after the flush", a.Options.Message)
+	}
+}
+
+func TestCoalesceWithoutWindow(t *testing.T) {
+	in := make(chan *gosxalerter.Alert)
+	out := gosxalerter.Coalesce(in, 0, nil)
+
+	for _, a := range burst(t, "build", "e1", "e2") {
+		in <- a
+		if got := receive(t, out); got != a {
+			t.Errorf("alert %q not forwarded right away", a.Options.Message)
+		}
+	}
+	close(in)
+}
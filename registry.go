@@ -0,0 +1,152 @@
+package gosxalerter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Registry holds named alert templates, usually loaded from a definition
+// file mapping names to Options:
+//
+//	deploy-confirm:
+//	  title: Deploy {{ .Service }}
+//	  message: Deploy {{ .Version }} on {{ .Env }} ?
+//	  actions: [Now, Later]
+//	  timeout: 5m
+//
+// Title, Subtitle, Message and Actions are text/template templates
+// rendered with the variables given to New.
+type Registry struct {
+	templates map[string]*optionsTemplate
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		templates: make(map[string]*optionsTemplate),
+	}
+}
+
+// LoadRegistry loads alert definitions from a JSON, YAML or TOML file,
+// the format is told by the file extension.
+func LoadRegistry(path string) (*Registry, error) {
+	var format string
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		format = "json"
+	case ".yaml", ".yml":
+		format = "yaml"
+	case ".toml":
+		format = "toml"
+	default:
+		return nil, fmt.Errorf("unknown alert definitions format %q", filepath.Ext(path))
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r, err := ParseRegistry(f, format)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return r, nil
+}
+
+// ParseRegistry reads alert definitions in format, one of "json", "yaml"
+// or "toml".
+func ParseRegistry(r io.Reader, format string) (*Registry, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions := map[string]*Options{}
+	switch format {
+	case "json":
+		err = json.Unmarshal(data, &definitions)
+	case "yaml":
+		err = yaml.Unmarshal(data, &definitions)
+	case "toml":
+		err = toml.Unmarshal(data, &definitions)
+	default:
+		return nil, fmt.Errorf("unknown alert definitions format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	reg := NewRegistry()
+	for name, opts := range definitions {
+		if opts == nil {
+			opts = &Options{}
+		}
+		if err := reg.Add(name, opts); err != nil {
+			return nil, err
+		}
+	}
+	return reg, nil
+}
+
+// Add registers opts as the template named name, replacing any template
+// of the same name.
+func (r *Registry) Add(name string, opts *Options) error {
+	t, err := parseOptionsTemplate(opts)
+	if err != nil {
+		return fmt.Errorf("alert %q: %w", name, err)
+	}
+
+	r.templates[name] = t
+	return nil
+}
+
+// Names returns the sorted names of the registered templates.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.templates))
+	for name := range r.templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Options returns a copy of the unrendered options of the template named
+// name, nil when there is no such template.
+func (r *Registry) Options(name string) *Options {
+	t, ok := r.templates[name]
+	if !ok {
+		return nil
+	}
+	return t.options.Clone()
+}
+
+// New returns an alert built from the template named name, rendered with
+// vars, then configured by opts. Empty Title and ReplyPlaceHolder get the
+// defaults of New.
+func (r *Registry) New(name string, vars interface{}, opts ...Option) (*Alert, error) {
+	t, ok := r.templates[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown alert %q", name)
+	}
+
+	o, err := t.execute(vars)
+	if err != nil {
+		return nil, fmt.Errorf("alert %q: %w", name, err)
+	}
+
+	a := newAlertWithOptions(o)
+	if err := a.apply(opts); err != nil {
+		return nil, fmt.Errorf("alert %q: %w", name, err)
+	}
+	return a, nil
+}
@@ -0,0 +1,92 @@
+package gosxalerter_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+	"github.com/vjeantet/gosx-alerter/gosxalertertest"
+)
+
+func TestParseRegistryTimeout(t *testing.T) {
+	definitions := map[string]string{
+		"json": `{
+			"seconds": {"message": "{{.}}", "actions": ["Now", "Later"], "timeout": 30},
+			"fraction": {"message": "{{.}}", "timeout": 1.5},
+			"duration": {"message": "{{.}}", "timeout": "1m30s"}
+		}`,
+		"yaml": `
+seconds:
+  message: "{{.}}"
+  actions: [Now, Later]
+  timeout: 30
+fraction:
+  message: "{{.}}"
+  timeout: 1.5
+duration:
+  message: "{{.}}"
+  timeout: 1m30s
+`,
+		"toml": `
+[seconds]
+message = "{{.}}"
+actions = ["Now", "Later"]
+timeout = 30
+
+[fraction]
+message = "{{.}}"
+timeout = 1.5
+
+[duration]
+message = "{{.}}"
+timeout = "1m30s"
+`,
+	}
+	want := map[string]time.Duration{
+		"seconds":  30 * time.Second,
+		"fraction": 1500 * time.Millisecond,
+		"duration": 90 * time.Second,
+	}
+
+	backend := gosxalertertest.New()
+	for format, data := range definitions {
+		t.Run(format, func(t *testing.T) {
+			reg, err := gosxalerter.ParseRegistry(strings.NewReader(data), format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for name, timeout := range want {
+				a, err := reg.New(name, "hello", gosxalerter.WithBackend(backend))
+				if err != nil {
+					t.Fatalf("%s: %v", name, err)
+				}
+				if a.Options.Timeout != timeout {
+					t.Errorf("%s: timeout %s, want %s", name, a.Options.Timeout, timeout)
+				}
+				if a.Options.Message != "hello" {
+					t.Errorf("%s: message %q, want hello", name, a.Options.Message)
+				}
+			}
+			a, err := reg.New("seconds", "hello")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := strings.Join(a.Options.Actions, ","); got != "Now,Later" {
+				t.Errorf("actions %q, want Now,Later", got)
+			}
+		})
+	}
+}
+
+func TestParseRegistryInvalidTimeout(t *testing.T) {
+	for format, data := range map[string]string{
+		"json": `{"a": {"message": "m", "timeout": "soon"}}`,
+		"yaml": "a:\n  message: m\n  timeout: soon\n",
+		"toml": "[a]\nmessage = \"m\"\ntimeout = \"soon\"\n",
+	} {
+		if _, err := gosxalerter.ParseRegistry(strings.NewReader(data), format); err == nil {
+			t.Errorf("%s: invalid timeout accepted", format)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+// Package remote delivers gosxalerter alerts through a gateway serving
+// package gateway, usually on another machine, so that a headless program
+// asks a user on their desktop.
+//
+//	backend := remote.New("https://mac.example.com:8080", os.Getenv("GATEWAY_TOKEN"))
+//	alert, err := gosxalerter.New("Promote to prod ?",
+//		gosxalerter.WithActions("Yes", "No"),
+//		gosxalerter.WithBackend(backend),
+//	)
+//	activation, err := alert.DeliverAndWaitContext(ctx)
+//
+// When the context of the delivery is done, the remote alert is closed.
+// Images given as local paths name files of the gateway host, they are
+// not checked on the client.
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+	"github.com/vjeantet/gosx-alerter/gateway"
+)
+
+// Defaults of the Backend settings.
+const (
+	DefaultRetries    = 3
+	DefaultRetryDelay = 500 * time.Millisecond
+	DefaultPollWait   = 30 * time.Second
+	DefaultTimeout    = 30 * time.Second
+)
+
+// Backend is a gosxalerter.Backend forwarding alerts to a gateway.
+type Backend struct {
+	URL        string        // Base URL of the gateway, such as "https://mac.example.com:8080"
+	Token      string        // Bearer token sent to the gateway, when set
+	Client     *http.Client  // HTTP client, http.DefaultClient when nil
+	Retries    int           // Retries of a failing request, DefaultRetries when 0, none when negative
+	RetryDelay time.Duration // Delay before the first retry, doubled on each retry, DefaultRetryDelay when 0
+	PollWait   time.Duration // Wait of each long-polling request, DefaultPollWait when 0
+	Timeout    time.Duration // Timeout of each request, on top of PollWait when long-polling, DefaultTimeout when 0
+}
+
+// StatusError is returned when the gateway rejects a request.
+type StatusError struct {
+	StatusCode int    // HTTP status code of the response
+	Message    string // Error reported by the gateway
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("gateway: %s (%d %s)", e.Message, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+type notification struct {
+	backend    *Backend
+	id         string
+	opts       *gosxalerter.Options
+	ctx        context.Context
+	cancel     context.CancelFunc
+	closeOnce  sync.Once
+	activation chan *gosxalerter.Activation
+}
+
+// New returns a Backend forwarding alerts to the gateway at url,
+// authenticated with token when not empty.
+func New(url, token string) *Backend {
+	return &Backend{
+		URL:   url,
+		Token: token,
+	}
+}
+
+// Remote tells that alerts are displayed on the gateway host, so the
+// images given as local paths are files of that host.
+func (b *Backend) Remote() bool {
+	return true
+}
+
+// Deliver posts opts to the gateway, as DeliverContext does without
+// deadline.
+func (b *Backend) Deliver(opts *gosxalerter.Options) (gosxalerter.Notification, error) {
+	return b.DeliverContext(context.Background(), opts)
+}
+
+// DeliverContext posts opts to the gateway, then long-polls the gateway for
+// the activation. The post and its retries carry the same Idempotency-Key
+// header, so the gateway delivers the alert once, and stop when ctx is
+// done.
+func (b *Backend) DeliverContext(ctx context.Context, opts *gosxalerter.Options) (gosxalerter.Notification, error) {
+	body, err := json.Marshal(opts)
+	if err != nil {
+		return nil, err
+	}
+	key := make([]byte, 16)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	header := http.Header{"Idempotency-Key": {hex.EncodeToString(key)}}
+	status, err := b.do(ctx, http.MethodPost, "/alerts", header, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Polling outlives ctx, the alert closes the notification when ctx is
+	// done.
+	pollCtx, cancel := context.WithCancel(context.Background())
+	n := &notification{
+		backend:    b,
+		id:         status.ID,
+		opts:       opts,
+		ctx:        pollCtx,
+		cancel:     cancel,
+		activation: make(chan *gosxalerter.Activation, 1),
+	}
+	go n.poll()
+	return n, nil
+}
+
+// Remove removes the alerts of group from the desktop of the gateway.
+func (b *Backend) Remove(group string) error {
+	_, err := b.do(context.Background(), http.MethodDelete, "/groups/"+url.PathEscape(group), nil, nil)
+	return err
+}
+
+// do sends a request to the gateway and decodes its answer. Requests
+// failing on the network or with a 5xx status are retried.
+func (b *Backend) do(ctx context.Context, method, path string, header http.Header, body []byte) (*gateway.Status, error) {
+	retries := b.Retries
+	if retries == 0 {
+		retries = DefaultRetries
+	}
+	delay := b.RetryDelay
+	if delay == 0 {
+		delay = DefaultRetryDelay
+	}
+
+	for attempt := 0; ; attempt++ {
+		status, retry, err := b.send(ctx, method, path, header, body)
+		if err == nil || !retry || attempt >= retries {
+			return status, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+		delay *= 2
+	}
+}
+
+// send sends a single request, bounded by Timeout, and tells whether it
+// may be retried when it fails.
+func (b *Backend) send(ctx context.Context, method, path string, header http.Header, body []byte) (*gateway.Status, bool, error) {
+	timeout := b.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	if method == http.MethodGet {
+		timeout += b.pollWait()
+	}
+	reqCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(reqCtx, method, strings.TrimRight(b.URL, "/")+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, false, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if b.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+b.Token)
+	}
+
+	client := b.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, err
+	}
+	status := &gateway.Status{}
+	if err := json.Unmarshal(data, status); err != nil {
+		status.Error = strings.TrimSpace(string(data))
+	}
+	if resp.StatusCode >= 400 {
+		if status.Error == "" {
+			status.Error = http.StatusText(resp.StatusCode)
+		}
+		return status, resp.StatusCode >= 500, &StatusError{StatusCode: resp.StatusCode, Message: status.Error}
+	}
+	return status, false, nil
+}
+
+func (b *Backend) pollWait() time.Duration {
+	if b.PollWait == 0 {
+		return DefaultPollWait
+	}
+	return b.PollWait
+}
+
+// poll long-polls the gateway until the alert is activated.
+func (n *notification) poll() {
+	path := "/alerts/" + url.PathEscape(n.id) + "?wait=" + n.backend.pollWait().String()
+
+	for {
+		status, err := n.backend.do(n.ctx, http.MethodGet, path, nil, nil)
+		switch {
+		case n.ctx.Err() != nil:
+			n.activate(&gosxalerter.Activation{Type: gosxalerter.ActivationTypeClosed})
+			return
+		case err != nil:
+			n.activate(&gosxalerter.Activation{
+				Type: gosxalerter.ActivationTypeFailed,
+				Err: &gosxalerter.BackendError{
+					Err:      gosxalerter.ErrBackendCrashed,
+					ExitCode: -1,
+					Cause:    err,
+				},
+			})
+			return
+		case status.Activation != nil:
+			act := status.Activation
+			if status.Error != "" {
+				act.Err = &gosxalerter.BackendError{
+					Err:      gosxalerter.ErrBackendCrashed,
+					ExitCode: -1,
+					Cause:    errors.New("gateway: " + status.Error),
+				}
+			}
+			n.activate(act)
+			return
+		}
+	}
+}
+
+func (n *notification) activate(act *gosxalerter.Activation) {
+	act.EffectiveTimeout = n.opts.Timeout
+	n.activation <- act
+	close(n.activation)
+	n.cancel()
+}
+
+func (n *notification) Activations() <-chan *gosxalerter.Activation {
+	return n.activation
+}
+
+// Close closes the remote alert, whose closed activation is then
+// received by the long-polling request. When the gateway can not be
+// reached, the alert is activated as closed right away.
+func (n *notification) Close() error {
+	var err error
+	n.closeOnce.Do(func() {
+		if n.ctx.Err() != nil {
+			// already activated
+			return
+		}
+		_, err = n.backend.do(n.ctx, http.MethodDelete, "/alerts/"+url.PathEscape(n.id), nil, nil)
+		if err != nil {
+			n.cancel()
+		}
+	})
+	return err
+}
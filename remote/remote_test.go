@@ -0,0 +1,126 @@
+package remote_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+	"github.com/vjeantet/gosx-alerter/gateway"
+	"github.com/vjeantet/gosx-alerter/gosxalertertest"
+	"github.com/vjeantet/gosx-alerter/remote"
+)
+
+// lostResponse serves the first POST with the gateway, but answers the
+// client as if the response had been lost by a proxy.
+type lostResponse struct {
+	gateway http.Handler
+	once    sync.Once
+}
+
+func (l *lostResponse) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	lost := false
+	if r.Method == http.MethodPost {
+		l.once.Do(func() { lost = true })
+	}
+	if lost {
+		l.gateway.ServeHTTP(httptest.NewRecorder(), r)
+		http.Error(w, "upstream connection reset", http.StatusBadGateway)
+		return
+	}
+	l.gateway.ServeHTTP(w, r)
+}
+
+func TestDeliverRetryDoesNotDuplicate(t *testing.T) {
+	backend := gosxalertertest.New()
+	backend.Reply("v1.2")
+	server := httptest.NewServer(&lostResponse{gateway: gateway.New(backend)})
+	defer server.Close()
+
+	client := remote.New(server.URL, "")
+	client.RetryDelay = time.Millisecond
+	alert, err := gosxalerter.New("Version ?", gosxalerter.WithBackend(client), gosxalerter.WithReply(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	activation, err := alert.DeliverAndWait()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if activation.Value != "v1.2" {
+		t.Errorf("reply %q, want v1.2", activation.Value)
+	}
+	if n := len(backend.Delivered()); n != 1 {
+		t.Errorf("delivered %d alerts, want 1", n)
+	}
+}
+
+func TestDeliverTimeout(t *testing.T) {
+	stop := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-stop
+	}))
+	defer server.Close()
+	defer close(stop)
+
+	client := remote.New(server.URL, "")
+	client.Retries = -1
+	client.Timeout = 50 * time.Millisecond
+	start := time.Now()
+	if _, err := client.Deliver(&gosxalerter.Options{Message: "hello"}); err == nil {
+		t.Fatal("delivered to an unresponsive gateway")
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("delivery failed after %s, want about %s", d, client.Timeout)
+	}
+}
+
+func TestDeliverGatewayImages(t *testing.T) {
+	backend := gosxalertertest.New()
+	backend.ClickContents()
+	server := httptest.NewServer(gateway.New(backend))
+	defer server.Close()
+
+	// The icon is a file of the gateway host, missing on this one.
+	icon := "/Users/me/icon-" + t.Name() + ".png"
+	alert, err := gosxalerter.New("hello",
+		gosxalerter.WithBackend(remote.New(server.URL, "")),
+		gosxalerter.WithAppIcon(icon),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := alert.DeliverAndWait(); err != nil {
+		t.Fatal(err)
+	}
+	if got := backend.Delivered()[0].AppIcon; got != icon {
+		t.Errorf("gateway received appIcon %q, want %q", got, icon)
+	}
+}
+
+func TestDeliverCanceled(t *testing.T) {
+	stop := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-stop
+	}))
+	defer server.Close()
+	defer close(stop)
+
+	alert, err := gosxalerter.New("hello", gosxalerter.WithBackend(remote.New(server.URL, "")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := alert.DeliverContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want context.DeadlineExceeded", err)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("delivery stopped after %s, want about 50ms", d)
+	}
+}
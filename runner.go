@@ -0,0 +1,71 @@
+package gosxalerter
+
+import (
+	"io"
+	"os"
+	"os/exec"
+)
+
+// Runner starts the alerter processes of an AlerterBackend. Wrap or
+// replace ExecRunner to sandbox alerter, run it on a remote host or
+// substitute a stub in tests.
+type Runner interface {
+	// Start starts the named program with args.
+	Start(name string, args ...string) (Process, error)
+}
+
+// Process is a program started by a Runner.
+type Process interface {
+	// Stdout returns the standard output of the process.
+	Stdout() io.Reader
+	// Stderr returns the standard error of the process.
+	Stderr() io.Reader
+	// Signal sends a signal to the process.
+	Signal(sig os.Signal) error
+	// Wait waits for the process to exit, once Stdout and Stderr are
+	// read. A non zero exit status is reported by an error with an
+	// ExitCode() int method, such as *exec.ExitError.
+	Wait() error
+}
+
+// ExecRunner is a Runner starting local processes with os/exec.
+type ExecRunner struct{}
+
+type execProcess struct {
+	cmd    *exec.Cmd
+	stdout io.Reader
+	stderr io.Reader
+}
+
+// Start starts the named program with args.
+func (ExecRunner) Start(name string, args ...string) (Process, error) {
+	cmd := exec.Command(name, args...)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return &execProcess{cmd: cmd, stdout: stdout, stderr: stderr}, nil
+}
+
+func (p *execProcess) Stdout() io.Reader {
+	return p.stdout
+}
+
+func (p *execProcess) Stderr() io.Reader {
+	return p.stderr
+}
+
+func (p *execProcess) Signal(sig os.Signal) error {
+	return p.cmd.Process.Signal(sig)
+}
+
+func (p *execProcess) Wait() error {
+	return p.cmd.Wait()
+}
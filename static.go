@@ -1,40 +0,0 @@
-package gosxalerter
-
-// build with
-// go-bindata -o tmp.go -prefix "/Users/sodadi/git/alerter/DerivedData/alerter/Build/Products/Release/"  /Users/sodadi/git/alerter/DerivedData/alerter/Build/Products/Release/alerter
-
-import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-)
-
-var _alerter = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\xbd\x09\x74\x54\xc5\x12\x30\x7c\x27\x0b\x04\x04\x82\x48\x14\x7c\x2c\x03\x82\x82\xb2\x24\x40\x7c\x21\x24\x92\x21\x19\x98\xc8\x0c\x84\x2c\x80\x0b\x86\x21\x99\x24\x23\xd9\x9c\x05\x88\x80\x04\x42\x94\x71\x88\x20\x6e\xb8\x3e\xd4\xa7\xe2\xf6\x1e\xae\x0f\x37\x8c\x82\x12\xf7\xb8\x20\xb8\x21\x2a\x4f\x27\xe2\x82\xe8\x43\x14\x64\xfe\xaa\xea\xea\x3b\x77\x6e\x66\x92\x78\xce\x7f\xce\x77\xbe\xef\x38\xca\xed\xae\xae\xa5\xbb\xab\xab\xab\xab\xfb\x2e\x79\xe7\xf7\xef\x4f\x76\x57\x14\x43\xac\xa2\xd4\xc7\x28\x8a\xd2\x1f\xfe\x59\x7a\x2b\x4a\xa3\x62\x54\xf0\x37\x10\x61\xf8\x57\x5c\x9c\x67\x9a\x61\xbe\xd8\x9c\x3f\x5b\x69\xf7\x33\xb4\x2f\x6a\xf7\x43\x39\x09\x71\x28\xa7\xd0\x3c\xbf\x30\x02\xff\x36\x1d\x03\xc3\xd0\x36\x25\x1e\xfe\xf5\xe2\xe2\xe2\x62\x8f\x63\x99\x27\x44\xa6\x97\xf7\xc8\x20\x21\xef\xf2\xc2\x10\x1c\xa3\x95\x1b\xa7\xd4\x6b\xc1\xe2\x62\xb7\xc7\xbb\xc8\x1d\x55\xde\xe9\x57\x08\x79\x57\x1b\xc2\x61\xf9\x4b\x60\x79\xdd\xc2\xe4\x15\x57\x38\x2a\x6b\x1d\xae\x08\xf2\xee\x70\x09\xfe\xdb\x62\x42\x70\xc7\xed\x2b\xa9\xa9\x76\x7b\xa2\xb6\x2f\xcd\x2b\xe4\x0d\xd0\xc0\xb1\x4a\xf4\x5f\x71\x71\x79\x49\x49\xb1\x63\x59\x89\xa3\xd6\x53\xec\xb1\x2f\xd2\xcb\xcb\x63\x79\x07\x35\x70\x4c\x87\xf2\x4a\xdc\x1e\x97\xb3\xba\x3c\x4a\xfb\x26\x2d\x11\xf2\x4e\x3b\x35\x04\x6b\x7f\x7a\xd9\xc5\xc5\x35\x8b\x2e\x2f\x29\xae\x72\x78\x2a\xaa\xed\x55\x8e\x76\xf2\x66\x5c\x23\xe4\xc5\x27\x86\xe0\x2e\xc8\x2b\xa9\xb4\xbb\xdd\x28\x50\x2f\xcf\x7a\xb3\x90\xb7\x43\x03\x77\xb1\x7d\x9e\xba\xda\xf6\xed\xeb\x79\x8b\x90\xe7\x8b\x0b\xc1\x1d\xcb\x73\x56\x97\xd5\x14\xd7\x56\x3a\xc5\x20\xeb\xe5\xdd\x70\xbb\x90\x77\x6f\x5c\x08\xee\xe8\x57\x5c\xec\xad\x5e\xea\xac\x2e\x25\xb1\x11\xe4\xa5\x6d\x11\xf2\xc6\x18\x42\x70\xc7\xe3\xeb\xa8\x28\x2e\x73\xe1\x50\x28\x91\xda\x57\x71\xb7\x90\xb7\xb9\x4f\x08\xee\xc8\xfe\xd0\x0f\x04\xe2\x51\x4e\x8e\xa9\xd0\xa4\x41\x6c\xe3\x79\x95\x1c\x82\xe9\x67\x14\x09\xfa\x01\x94\xdb\x4f\x6d\x57\x75\x65\xb1\xbb\xae\x6a\x51\x4d\x65\x71\xad\xc7\x15\x55\x5e\x5f\x0d\xac\x6d\x17\xce\x57\x53\x58\x3f\xcb\x6b\x3c\x61\x70\xb8\xbc\xbe\x2c\x6f\xbb\x06\xd6\xcb\xcb\x0e\xe3\xaf\xb4\x77\xd4\xbe\x16\x96\x97\x10\x13\x82\xb5\xf2\xb0\xbf\x17\x87\xc9\xd3\xfb\x81\x70\x79\xad\x8f\x0b\x79\x5b\x34\x70\x9c\x12\xfd\x07\xf2\xca\xb4\x13\x57\x2f\xaf\xf6\x09\x96\xd7\x3d\x04\x77\xec\x57\x42\xf3\x0c\x6d\xb9\x9d\xfe\x9e\xe3\xfe\x6a\x60\x9d\xbc\xbe\x11\xe4\x55\x57\x96\x54\x46\x94\x37\x40\x27\x6f\x40\xd7\xe4\x95\xd8\x3d\x62\xa6\xe9\xe5\x19\x9f\x0b\xb7\x17\x63\xd7\xe4\xd5\xba\x6a\x3c\x35\x91\xda\x97\xcc\xf2\x46\x69\xe0\x2e\xe8\xcf\x59\x65\x2f\x77\x90\x47\xd0\xc9\x9b\xaf\xeb\x2f\xc2\x1d\xcf\x5b\xd1\x5f\x69\x34\x7a\x79\x0b\x59\x5e\x5a\xaf\x10\xdc\x85\xf6\xb9\x1d\x95\x2e\x47\x99\xbb\xbd\xbc\xcd\xbb\x85\xbc\x63\xb1\x21\x58\x2b\x2f\xbe\x03\xfd\xa1\x44\xbd\xbc\x8d\x6f\x84\x8f\x07\xc2\x5d\xb5\xbf\x48\xf2\xb6\xb0\xbc\x16\x0d\xdc\x85\xf1\x2d\xb5\x7b\xec\x0c\x87\xcb\xab\x78\x33\x7c\xbe\x21\xdc\x71\xfb\x54\x51\x11\xe5\x25\xbc\xc5\xfa\x33\x84\xe0\x8e\xe5\x95\xd4\x54\x55\xd5\x54\x47\x93\xa7\xbc\x13\x1e\x17\xe0\x4f\x2b\x4f\x1f\xb3\x15\x17\x2f\x72\xbb\x95\xe8\xed\x33\xb2\xbc\x7a\x8d\xd1\xc5\x75\x20\x2f\x14\x37\x5a\x73\x67\xcd\x34\xe7\xe4\xca\x35\xe3\x00\xd3\x6e\x61\xb8\x55\x24\xdb\x78\x5d\xeb\xae\x93\x35\x1c\xaa\x4c\x66\xba\xc3\x90\x1c\x86\xb4\xb6\x5b\x08\xbf\x70\x2f\xe8\x1e\xe0\x96\xcf\xc4\x52\x11\xc3\x7d\x5e\xb8\x5f\x51\xae\x01\x20\xa3\x87\x41\x49\xcb\x50\x94\x53\xa0\x2c\x8f\x79\x4e\x8f\x11\xff\xb0\x9e\x33\x20\xad\x55\xa2\xff\x5a\xbb\x19\x94\x48\x4b\x2e\x2e\x77\x58\x1f\x4e\x9f\xf1\x5e\xb7\x6b\x7c\xa5\x73\xd1\xf8\xd2\xba\xca\x52\xc6\xff\x8d\xdb\x91\xd5\x70\x59\xef\x11\xee\xe4\x8f\xb6\x5c\x99\x50\x3e\xef\xcd\xcb\xd7\x8d\x50\xd8\xd0\x12\x7a\x42\xa3\x7a\x2a\xe7\x2a\xe1\x86\x07\xfe\xa2\x1e\xf9\xe6\xe6\x85\xd7\x87\xf5\x2c\x64\x99\x34\x04\x86\x40\x1c\xae\xe2\xe3\x0b\xea\xdc\x1e\x47\xd5\x78\xab\x73\x91\xcb\xee\xaa\x1b\x3f\x1d\x97\xea\xa5\x35\xae\xc5\xee\xf1\xd3\x6b\xbc\xd5\x60\x74\xce\x9a\xea\x71\x65\xb2\x74\xfc\x5c\x87\xcb\x0d\x25\xee\xf1\xd9\x1a\x3c\xcb\x4f\xd3\xca\xa7\x08\xd0\x10\xea\x1b\xfc\xc3\x19\x31\xce\x34\x0e\x7a\xe9\x5c\xa4\x6d\x97\x96\xcf\xd0\xf3\xf5\x38\x3d\x9f\x68\xe2\xb8\x69\x5a\x4e\xe4\x9b\xaf\xad\xcf\xb8\x02\x5c\xc4\x58\xa5\x83\xfe\x98\x6a\x6b\x67\x3a\x3d\x91\xfb\x22\x70\x2c\xb7\x42\x2b\xb7\x0f\xea\xe9\xe6\x8e\xe4\x66\x83\x03\xea\x58\x57\x26\x1d\x0d\x88\x3d\x9b\xc7\x2d\x00\x76\xb7\x0c\xd2\xd1\x0c\xa3\xdd\xe1\x6f\x30\xc3\xf5\x66\x83\x92\x7c\x76\x54\xf3\xfa\xeb\xf7\xd7\xef\xaf\xdf\x5f\xbf\xbf\x7e\x7f\xfd\xfe\xfa\xfd\xf5\xfb\xeb\xf7\xd7\xef\xaf\xdf\x5f\xbf\xbf\x7e\x7f\xfd\xfe\xfa\xe9\x7e\x45\x16\xdf\x7f\x4d\x73\x0b\x72\x7d\x27\x2d\xfe\xcc\xaf\xfe\xa5\x28\x16\x7f\xea\x07\x0f\x29\x4a\x30\xe9\xed\x1b\x00\xf0\xed\x0e\x9c\x2c\xc4\x74\xa7\xc5\xf7\x79\x30\xe9\x75\x28\xb3\x4e\xfe\xd9\x73\x26\x50\x3d\x0d\x54\x56\xdf\xaf\xc1\xa4\xfb\x98\x70\x0f\x10\x1e\xfa\x9b\xc5\x1f\x7f\xe2\x31\x28\x68\x6c\xb6\x34\x65\x2e\xba\x19\x72\x89\xe6\x63\xc1\xa4\xcd\x82\xe8\x12\xd3\x65\x0b\xda\x4a\x0b\xd5\x7a\x41\xce\x12\x94\xe3\x4f\x2d\x06\x02\x53\xf0\x43\x14\xb4\x9e\x6b\xf4\x67\x5e\x2c\x1a\x74\xc9\x43\x02\xe7\x4f\xbd\xe8\x21\x44\x7d\x6e\xf1\xbd\x0c\xf0\xda\x66\xcf\x0c\x28\xb3\x61\x59\x53\xd2\xf9\x37\x13\x2a\x98\x94\x04\x92\x00\x35\x06\x50\xe9\x80\x7a\x16\x8f\xa4\x08\x71\x62\x93\x68\xe8\x18\x90\x9f\xeb\x7b\x95\xca\x8e\x6d\xc2\x5e\xfc\x0c\x79\x6a\xda\x6b\x05\xdc\xb4\x79\xa6\xb9\xa6\xc2\x02\x4b\xc3\x77\xc9\x56\x7f\x66\x09\x10\xd9\xfc\x53\xad\xbe\x95\xfb\xa0\x51\x6f\x3d\x4a\x8d\xfa\xfa\x41\xd4\xd2\x56\x96\xb9\xaf\x40\xc8\xf4\xc7\xf7\x81\x92\x23\x89\x7d\x95\x40\x4a\x21\x65\x7a\x8f\x9c\xa7\x28\x65\x89\xe3\x5a\xdc\xe3\x2c\x4d\xf1\xdb\x6e\x42\x7a\x73\x0b\x64\x5f\x11\xd9\x56\x10\xb9\x46\x88\xbc\xfd\x41\xec\x49\x51\xab\xa5\xc9\xd6\x72\x68\x0c\x90\xbc\x2c\x48\xb6\x43\x76\xaf\xc8\x36\x03\x75\xa1\xa0\xae\x12\xd4\xa0\x67\xdb\x76\xd3\x76\xec\x65\x30\x69\x20\x37\x27\xb7\x80\x55\x98\x3a\xfb\x41\x1a\x26\x8b\xef\xe3\x60\xd2\xf1\xeb\x51\xd5\x23\xe3\x88\xe8\x73\x53\xf0\x03\x40\xe0\x75\xca\xca\x7d\xde\xde\x96\x86\x5d\xc9\x97\x98\x2e\x35\x5d\x66\x2a\x5e\xb0\x33\x90\xa3\x55\x44\x11\xa8\x22\x0f\x2a\xde\xf9\x08\x55\xfc\xe4\x56\x12\xf3\xe8\xf5\x38\x2a\x1f\x60\x75\x1f\xe7\x63\xef\x77\x03\x2e\xb0\x95\x06\x23\xf7\x26\xac\x35\xc8\xe8\xe7\xf2\x45\xab\x1e\xcd\x97\xad\x7a\x6d\xab\x6c\x02\x28\xcd\xea\x1f\x7b\xc5\xf5\x0c\xef\x21\xae\x3d\x50\xd9\x5c\x51\xd9\x8c\xad\x6a\x2d\x1e\xb5\x96\x35\xa2\x96\xd8\xb0\x5a\x8a\xb8\x96\x19\x6a\x2d\x25\x9a\x5a\x76\x85\x89\x6f\xca\x4c\x84\x51\x49\x69\xb6\xfa\x8e\x5a\x7d\x5f\x5a\x7d\x3f\x41\xef\x13\xb0\xf7\x0b\xa8\xff\x6d\xf7\xe5\xeb\xba\x0f\x96\x30\xdf\xe2\x5b\xb9\xd5\xe2\xdb\x1b\x4c\x7a\x63\x23\x8d\xc5\x66\x68\x65\xeb\xc3\xd4\x4a\x03\xa9\x64\xec\x6d\x1b\xb1\xb1\x7b\xb0\x15\xbb\xe7\xb0\x41\xa4\x7e\xff\x00\x8d\x00\x97\xff\x7b\x8e\x6c\xdd\x67\x0f\x50\x27\x2e\xbb\x51\x76\x1d\xfa\x06\x6a\x2d\xdb\x18\x3e\x3a\x96\xed\x41\xf1\x5b\x95\x3b\x79\x77\x62\xe3\x99\x3d\xb0\xc2\xcc\x85\xa2\xde\x07\x1f\x50\x2b\x9c\xab\x56\x78\x47\x58\x85\x17\xcc\x91\x5a\x5b\x2f\x2a\xec\x7d\x23\x6b\x8d\xd0\x43\xb9\x3d\xbe\x05\x1b\x85\x2a\xb9\x56\x7f\x6a\xa6\x20\xdf\x21\xad\x65\x8f\xe5\x19\xd9\x12\xcb\xe4\x16\x9b\xef\x87\xc4\xb5\x5b\x12\xa8\x31\xcf\x3d\x24\xfc\xc6\xfd\xea\x14\x7e\x2d\x0f\x2b\x7d\x05\x0a\x87\x0a\x29\xfb\xd0\x73\xf8\x7e\x64\xf4\x43\x79\x6c\xd7\xfe\xd4\xe0\xfd\x84\x17\xf3\x50\xe2\xaf\xcd\x93\x6d\x3e\x20\xd0\xcf\x84\xb1\x2f\xce\x93\x3a\x7c\x49\xa0\x9f\x0d\x43\x5b\x89\x7b\x17\xa0\xef\x11\xe8\xe7\xc2\xd0\x63\x45\xe5\xdb\x6c\x8d\xc1\xc4\xc6\x66\x98\x36\x29\xc1\xc0\x9d\x50\xd6\xd8\x9c\xd8\xf8\x18\xf9\x8a\x05\x2d\x16\xff\xe0\x6e\xd4\xa9\xcc\xee\xa2\x6f\x0b\x41\x92\x0d\x35\xb3\x17\x45\x7c\x3b\x5b\x4e\xdd\xd4\xd9\xf7\x93\xd5\x31\xe2\xdd\xd9\x72\x10\xa6\x52\xf9\xe7\x56\xdf\x11\x46\x3d\x2d\x78\x9a\x71\x32\xfa\xe3\xeb\xaf\xa3\x2e\x04\xdf\xb7\xf8\x57\xb6\xe2\x35\xb3\xfa\x41\xaa\x28\x91\x94\xb8\x17\x72\xbd\x21\xf7\x4c\x1c\x35\x08\x34\xb1\xa0\x19\xa6\x30\x89\xfa\xdc\xe6\x3b\x09\xca\x09\x26\x65\xa0\x0c\xff\x82\x16\x5b\xe3\x41\x6f\x1f\x4b\xe3\x27\xde\x1e\xd8\xa5\xb5\x53\xbb\x8b\x3e\x40\x71\x62\xa3\xad\x3b\x49\xbd\xf5\x3e\x52\xc4\x84\xeb\x49\x11\xc1\xa4\x9f\x9a\xc4\x5c\x19\x36\x3b\xe4\xdc\xbf\xc7\xb2\xc6\x4f\x12\xd7\xfa\x15\xa4\x5a\xd9\x1c\x78\x6a\x96\x50\x64\x53\x66\x77\xbb\xa2\x04\x76\x11\xb8\x1b\x2a\xf1\x14\xb3\xfd\x82\xc9\x04\x4c\xb3\xe4\x68\xdc\xfb\x4f\x51\x32\x7e\x16\x7b\xe9\x57\x03\x0b\x67\x51\xfd\x03\x45\xfd\x8f\x6f\xe4\xfa\xaf\xe0\xfa\xef\x26\x74\xe6\xeb\x68\xcd\xbe\xf8\x9d\x0f\xa3\x0f\x73\x34\x21\xd1\x37\x81\x94\x59\x38\xa7\x56\x36\x5b\xfd\x0e\xec\x8b\x67\x08\xc8\xe9\x81\x72\xfc\x2b\xb7\xc2\xa4\x0d\x26\x8d\x01\x42\x68\x8c\xb7\x4f\xdb\xf2\x6e\x94\x4b\x5c\xfb\x6c\xbc\x68\x3a\xfa\x8f\xad\x54\xf3\xe7\xff\x54\x8d\x72\xd2\x2c\x69\x17\xd7\xfe\x93\x9a\xb3\xf3\x7a\xad\x5d\x24\xcd\x0a\xe9\xe2\x8b\xf5\xa4\x0b\xcf\x05\x40\xbc\x00\x7b\xd5\x94\x79\xb3\x20\xb6\xfa\x7e\x67\xfa\xff\xda\x64\xbf\x1b\xa8\xdf\xdf\xc0\x00\x59\x7d\xbf\x11\x16\x44\xdc\xb5\x9e\xea\x1f\x2a\xaa\x5a\x26\x35\xbf\x74\xbd\xe8\xf9\x03\xb6\x50\x6d\x6e\x4d\x6d\x3f\xde\x4b\xb5\x59\xf4\xb5\x2d\x55\x6b\x4b\x8a\x54\x5b\xb2\xa8\xed\xb1\x7b\xa9\xb6\x73\x65\x6d\x3d\xb8\xb6\xc9\x9a\xda\xe2\x35\xb5\xad\x16\xb5\xfd\xbe\x51\x57\x5b\x0f\xb5\xb6\x07\xee\x8d\x50\xdb\xcb\x7e\x1a\xb6\xf7\xef\xa7\x4a\xe3\xee\x55\x55\xfc\xae\x15\xa9\xab\x5a\x6d\xbe\xff\xda\x7c\xbf\x88\x29\xfc\xfd\x3d\x5a\x2f\xfd\x6f\xab\x94\x5c\x24\xda\x7a\x97\xea\xef\x4c\xeb\x70\x4d\x86\xc9\x51\xe2\xe7\xc9\x01\x6c\x36\xdf\x37\x36\xdf\x77\x56\x7f\x15\xcc\x12\x53\xe2\xd3\xaf\x36\x1c\x33\x24\x36\x36\x2a\x54\xf1\x29\x42\xc2\x1d\x28\xa1\x69\xb0\x77\x23\xb7\xf4\x23\x31\x7f\xee\xa3\x7a\x7f\x94\xd3\x06\xc6\xdf\x1a\x52\x82\xe2\x0f\x29\x61\x05\x12\x36\xa5\xa6\x4a\x25\xc8\x19\x1b\xa7\x36\xf5\x9e\x7b\x42\x4a\xf8\x49\x4c\xc2\x60\xd2\x0b\xd7\x52\x23\xae\xbe\x87\x1a\x31\x8f\x1a\xd1\x3b\x59\x34\xc2\xea\x73\x6c\xa1\x56\x64\xfe\x9b\x2c\x36\xf5\xc0\xdd\x62\x5a\xe3\x2a\x38\x53\xfa\x88\xf7\xef\x66\x47\x4d\xe5\x37\xcd\x94\xd5\x4d\x11\x22\x47\x48\xcd\xec\xb5\xf9\x4e\x98\xd6\xed\x16\xca\x99\x7e\xad\xaa\x9c\x5f\x49\x25\xbb\x41\x25\xde\xa1\xc0\x76\xe2\x6e\xe1\xdf\x45\x4b\xee\xdf\x40\x53\x65\x4b\x30\x29\x4e\x34\xf4\x26\x81\xee\x23\xe7\xe0\xf7\x3e\x61\x1b\x83\x67\x86\xd4\xd2\xe6\x23\xb5\x80\xd2\x61\xd6\x6d\xf1\x4c\x05\xb6\xcb\x04\xdb\xde\x0d\x3c\x57\xa8\xad\x6d\x17\xca\xb6\x5e\x43\xe8\xde\xfb\x36\x48\x0d\x49\xf5\xdc\xe5\x13\xf6\x2f\xd8\x05\x1e\xed\x9f\x6b\x7d\xe0\x42\x8d\xfd\xfb\x34\xf6\xbf\x85\x06\x63\xeb\x06\xdd\x60\x2c\x55\x6b\x4c\xba\x3b\xc2\x60\x24\x8b\xda\x1e\xdb\x42\xb5\x5d\x2b\x6b\xeb\xc1\xb5\x4d\xd6\xd4\x16\xaf\xa9\x6d\xb5\xa8\xed\x62\x7d\x6d\x3d\xd4\xda\x1e\xd8\x12\xa1\xb6\x97\xd7\x51\x6d\x7e\x51\xdb\xa5\x1b\xc8\xfe\x52\x37\x84\xdb\xdf\xc8\x2d\x3a\xfb\x7b\x32\x37\xd4\x88\xfa\x75\xa1\x46\x9c\xf8\x07\x35\xe2\x7f\xd7\xe9\x1a\xb1\x36\x57\x36\x62\x78\xa4\x46\x64\x52\x23\x06\x9f\x7f\xaf\x58\x37\x90\xc4\xef\xdd\x2a\xed\xe9\x25\x28\x8b\x15\xed\x3b\x71\x1d\x86\x3a\xc2\x92\x28\xfe\x55\xe5\xbe\xfe\x0f\x1a\xbc\xe7\xae\x0b\x1f\x3c\x9a\xbd\x27\x20\xf0\xfd\xf8\x1a\xb9\x18\xcd\x27\xca\xa4\x77\x69\xc9\x59\xd9\xca\x93\xf9\x4b\x8b\x90\xd4\xf8\x09\xe0\xf6\x20\x2e\xd1\x0c\x6b\x59\xea\x26\xa4\xf6\x9b\xb7\x59\x7c\x71\x23\x68\xbd\xb3\x6d\x87\xb6\x35\xdb\x7c\x87\x6d\xbe\x36\xe2\xc5\xb6\x60\x67\x61\x5e\x93\x7d\xff\x8a\x5e\x1e\x24\x6f\x03\x0b\x2f\xbd\x46\xb5\xf0\x6f\xc8\x07\xd0\x2a\xb9\x1d\xb3\x3f\x52\x76\x23\x5d\x37\x5b\x7c\xfb\x20\x6c\x9b\x1f\x0a\xdb\x82\x07\xa0\xee\xeb\xee\x12\x4b\x44\x30\xa9\x17\xc8\x39\x54\x12\xf0\xcd\x10\x36\x90\xca\x8d\x6d\xca\x3c\xcf\x2f\x14\xb2\x3f\x50\x4e\x38\x50\xe6\xe1\xab\x15\xe5\x45\x8c\xa1\x03\x1e\x0b\xe9\x73\x86\x2a\xe6\x15\x15\x75\x91\x40\xad\xb8\x4b\xb3\x08\x3d\x70\x35\x05\x10\x17\x58\xda\xc5\xcb\x18\x29\xde\x7d\x35\xcf\xf5\xcc\x77\xee\x26\xde\xfa\xbb\x28\x50\xbc\xe2\x6a\xd4\xf8\x11\x8e\xc1\x6e\x9f\x21\xc3\x1d\xe7\x5d\xda\xd8\xac\x61\x86\x1c\xa8\x8b\xee\x52\xd4\x20\x71\x17\x35\x78\xea\xd5\xb8\xe4\x1d\xf4\x8e\x03\xd9\x6e\x21\x3b\x83\x98\x8f\x04\x93\x4e\xbb\x5a\xf4\x38\x5b\xf0\xa3\x06\x93\x12\xa9\x25\xe0\x40\x32\x6d\x82\xfa\xfe\x3b\x45\xe8\xe8\x4f\xed\x45\xc2\x77\x93\xf7\xdf\x83\xf4\xfe\x91\x5f\x34\x4a\x9f\xcd\xe1\x20\x70\x86\x47\xc8\xff\x98\xde\x3e\x42\x4e\xb3\xf9\xde\xb6\x41\xd4\xe7\x7b\x03\x42\xe5\x16\x18\xd1\xbe\x60\x43\x77\x35\xa2\xf4\xbd\x64\x59\xe6\x2d\xd2\x0a\xcd\xdb\xa4\x33\x31\xb3\xc9\xe2\x46\x80\xdc\x4e\x8b\x28\xc6\xe0\xf0\xcb\x3b\xc8\xea\x96\xfb\x79\xab\x99\xde\x28\x3a\x76\xf9\xf4\xd0\x4c\x3a\xbf\x51\xcc\x24\xf4\x55\x8f\x0b\xfa\x99\x48\xef\xcf\xec\xd5\xa8\x75\xaf\xe7\x4f\x97\xca\xdc\x75\x87\xde\x57\x1d\x59\x4b\xeb\xd9\x94\x2d\x62\x3d\xbb\x93\xd8\xdf\x5a\x4b\xc6\x0f\x61\x2c\xae\x68\x50\xfe\xcb\x1d\x62\x42\xfb\x17\x6c\x11\xbc\x56\x9f\x17\xa2\x93\xd4\x27\xd7\xf2\xe0\xe0\x0e\x75\xaf\x20\x82\x7e\x40\x0f\xbf\x24\xa2\x6f\x04\xe6\x15\x95\x7d\xab\x5a\xb5\xc0\x3c\xa1\x62\x64\x90\xe7\x8f\xdf\xdd\xc0\xdb\xc8\x6f\xcd\x62\x1b\xb9\xdf\x22\xb6\x91\xb9\xbe\xcf\x12\x1b\x3c\x06\x6a\xe9\xcb\xb7\x0b\xfd\x5c\xcb\xee\xee\xcc\xb5\x42\x3f\x17\x9a\x43\xfa\x39\x7d\x2d\x87\x75\x0f\x89\x45\xf3\x3a\xc1\x33\xe3\x5a\xad\x3f\x1f\x68\xc6\xf0\xf6\xa4\x30\xc2\x31\x77\x90\x7d\xb7\x84\xc2\x81\xdf\x73\xa4\xee\x06\x90\x86\x7b\xf7\x20\x0d\x3b\x5a\xa5\x1a\x3f\x14\x6b\xd3\x43\x0d\xda\x85\xfb\x57\x8a\x6b\x7f\xa0\xd9\x92\xfa\x0d\x54\x9b\xf2\x1e\x57\xf7\x50\x8e\x5c\x02\x5b\xa8\x35\xbd\x3f\xba\x96\x97\xcd\x23\xa4\x31\xe2\x4c\x3d\x78\x1b\xb5\xf4\x05\x1f\xf7\x2e\xa3\x41\xf4\xae\x32\x27\xd4\xbb\xb4\x06\x31\xfa\x65\xb8\x2f\x15\xf4\x4d\x3e\x6d\xcf\xa6\xe6\x68\x7a\x96\x7f\xbb\xbe\x67\x46\xb5\x67\xb3\x04\xae\x55\xdb\x9f\x8f\xd7\xb4\xeb\x4f\xea\x2c\x51\xcb\xee\x6b\xb8\x55\xf7\xaf\x11\xad\xfa\x30\x3b\xd4\xaa\x7b\xd6\xb0\xce\xd7\xd1\x38\x65\x7e\x7b\x27\xe9\xe0\xe7\xdb\xf8\x38\x82\xdb\x76\x6f\xb6\x54\xc3\x4b\x24\xb4\xf7\x11\x9f\x68\x83\xaa\x07\x98\x8a\x73\xd7\x88\x0d\x30\xec\xaf\x6e\xa5\x9a\x6d\xd7\x68\xfb\x57\x9c\x2d\x3b\x70\xa7\x68\xd8\xdb\x3e\x19\x35\x20\x3a\x2b\x1b\xbb\xff\x03\xee\xc5\x28\xc6\x02\xba\x95\xb7\xa9\xfc\x0d\xc7\x62\x12\xd7\x3c\x2a\xda\x38\x5d\xb4\x71\xb6\xae\x8d\xc1\x69\x52\xfe\x60\xd1\xc6\xeb\x48\xbd\x55\xdb\xd0\x75\x6b\x96\x37\x16\x7e\x8c\xda\xd8\xdb\xe7\x63\x8b\x2e\xda\x26\xd6\x8f\x01\xa2\xed\xc2\xef\xc9\x29\x79\xef\x34\xa9\x32\x64\x6e\xfc\x04\x44\x6e\x4b\x5c\x3b\x57\xd8\xf6\xd7\x9b\x89\x03\xfd\x1c\xa8\xa4\x99\x59\x6a\xa7\x49\x95\xed\x40\x89\xfe\x05\xad\xea\x26\x8b\x3c\xc4\xa8\xd5\xc4\xfc\xe0\xad\xa1\x83\x1f\x40\xb5\xc5\x08\x99\x9b\x84\xcc\x99\x57\xf3\xd8\x7d\x5b\x2f\xc6\xee\xcc\x69\xa1\xb1\xfb\xba\x9e\xc7\xee\x13\x9a\x2f\x99\xe3\x68\x36\xa4\xfe\xfd\xd6\x70\xbd\x7c\x63\x92\x7a\x89\x17\x5d\x3e\x7f\x5d\xf8\x94\xd8\x2b\x4c\xe8\x96\x7a\x6a\xb0\x98\xe8\x67\x4a\x21\xe4\x14\x50\x2f\x8a\x68\xd1\x33\x8d\xda\x31\xf5\xab\xb2\x7f\xda\x1c\xee\xaa\x40\xcc\xa1\xe9\x78\x7e\x72\x0b\xb1\x4d\x0b\xf3\x70\x85\x2a\xdb\x3d\x9b\x35\xb6\x2c\x5c\x5c\x62\x3d\xf7\xb9\x1b\x66\xfc\x55\xcd\xb8\x6f\x11\x52\x9e\x5f\xcb\xa8\x4f\x56\x09\x75\x9c\x62\x0a\xa9\x63\xef\x2a\x52\x07\x2c\xdf\xdb\x3d\x93\xd1\x3e\x04\xcf\xba\xb5\xda\x06\x7f\x9c\x25\x6b\xb6\xb4\xaf\xf9\xa6\x55\xb8\x52\x1d\xf5\x4c\x00\x74\x8a\xe8\xee\xb5\xd7\xc8\x76\xaf\x85\x76\xc4\x7f\x75\x15\x2d\x5c\x10\x3c\xf4\xc5\xf8\x6e\xb3\x8c\x31\x30\x7e\xfe\xcf\x6d\xa4\xfe\x84\xcd\xaa\xe6\xab\xd4\xca\x8e\xdf\x42\x03\x0c\x56\xd6\x22\xb4\x79\x44\x14\x88\x61\xf8\x52\x1d\x83\xd3\x56\x85\x07\x14\xbf\x52\x28\x21\xc2\x87\x34\x6d\xf8\x10\xbe\xa0\xad\xfe\x2e\x81\xcc\x26\xbe\xe4\x2a\x4c\xc4\x8e\x9f\xce\x7f\x10\xf6\x35\x26\x04\x83\x41\x68\x61\x92\x68\x61\xd9\x2d\x74\x8e\x79\xcb\x55\xea\x66\x68\xd7\x54\x22\x3b\x7c\x32\x18\x4c\x9c\xd7\x9c\x38\xba\x31\x0b\x18\x20\x49\x16\x89\x51\x24\x7d\x49\x4a\xea\x54\x6a\xfa\x6e\x66\xf5\x09\xd6\x03\x27\x09\x77\x1e\xe9\xfc\x26\xa2\x6c\x6a\xca\x83\xc4\xb4\xbd\xaf\x12\xa2\x6f\x54\x10\xd3\xd8\x9c\xb8\xf6\x02\x6a\x30\x89\xa6\x06\x37\x1e\x03\x09\xe6\x94\xa0\x5a\xd6\x84\x05\x96\xc9\x09\x9e\x5e\x16\xff\x0b\x28\x3e\x10\xb8\x80\x58\x06\x00\x7a\x96\x7f\xc4\x31\x08\x8f\x7a\xd1\x94\x4b\x6d\xc4\x03\x55\xff\x4d\xd8\xcb\x60\x52\xf3\x4a\x3c\x54\xf5\xba\xd1\x18\xc1\xbe\x6e\x56\x3b\xf9\xf9\x05\xd2\x56\xa0\x7c\xc2\x4d\x34\xbc\x6f\x34\xa8\xe8\xe6\x0b\xd0\xff\xfc\x8f\x16\x77\x88\x03\x53\x93\x43\x42\x21\xd8\x58\xd7\xf8\x23\x34\x01\x30\xe0\xa2\x20\x2a\x5a\xbc\x92\x4f\xb3\x30\xd0\xa9\x7f\x01\x71\x8a\xe7\x6c\xab\xff\xd1\xc3\x44\x05\xee\x64\x30\xf1\xfb\xf6\xd3\xe8\x7e\x48\x83\xc8\xf9\xdc\xe0\x6e\xeb\x94\x17\x50\x11\x89\x6b\xfa\x84\x34\x24\x3a\x89\xeb\xcf\x4d\x7a\x1d\x06\x93\x0e\xad\x20\x2d\x4b\xed\x35\xbe\x4f\xa4\x83\x0f\xae\x50\x19\xd1\xe3\xbf\x70\x58\x66\x48\x15\x90\x89\xcf\x5f\x21\x0c\x62\x8a\xb9\xd5\xdb\xcf\xb2\x7a\x17\xda\x49\xc8\x8e\x76\x06\xba\x5d\x10\x21\x38\x02\xbe\x33\x57\x84\x1b\xd2\x9a\x15\xe4\x1c\x20\x8e\x80\x68\x27\xf3\x83\x5b\x48\xef\x45\x37\x51\x88\x38\x6b\x85\x7a\xb4\xb7\x3c\x93\x0e\x2e\x60\x53\xb9\x0d\xcf\xe5\x6e\x62\xff\x41\xb8\xe2\x4c\x8e\x1d\x33\xef\x14\xec\xd3\x6f\x64\x3e\x3c\xd3\xdb\x8a\xf3\x49\x0c\xca\x5c\x19\xa5\xec\xb1\x34\xc5\x3b\x09\xc0\xc3\x28\x2f\x44\xdd\x99\x97\x0b\xd6\x9f\xf1\x28\xb2\xa9\xa8\xf9\x99\x18\x45\xc6\x86\x01\x71\x3a\x49\x07\xee\x08\x1f\x10\x30\xe9\x1c\xe1\x7d\x3a\xb8\x55\x3d\xcd\x04\xb1\x7f\x13\x62\x77\xdd\xa8\xf6\xe4\xb1\x0c\x39\x77\xff\x13\x76\x0a\x79\x6b\x06\x29\x02\xdd\xc4\x1c\x9b\xef\x17\x61\x2b\xa5\x37\xe0\x71\xd0\xf4\xe5\xe8\x80\x9a\xc1\xf9\x90\x89\x5d\x43\x7c\x5f\x8a\xa3\x5c\x80\x6b\x42\xc2\x0b\x33\x58\x9b\xdf\x04\x93\x8c\xcb\xc9\xe9\x80\x2c\xef\xe3\x18\x3f\xdf\x48\xce\x79\x2b\xeb\xcd\x9f\x9a\x77\xa3\x54\x22\x0c\xfc\x8d\xe6\x07\x40\xe1\xdf\x5f\xa9\xa8\x67\xd0\xbf\xd2\xf5\x1b\xcd\x79\xf4\xca\x6d\x22\xd9\x4e\x49\x7c\xde\x95\x21\x0b\x38\x2d\xf1\x69\xf3\x03\xe1\xae\x64\x67\x20\x36\x43\x98\x00\x1d\xcc\x2f\xd8\xa9\xf7\x2c\xeb\x68\xfa\xbe\x91\xeb\xfb\x03\x7c\xc6\xf2\x2b\x39\x44\xc6\xb9\x5c\x81\xd6\x28\x22\x43\x9a\xde\x74\x62\x95\xb9\x8d\x16\x82\x91\x0f\x6e\xa6\xd0\x74\xda\x95\xe4\x0d\x53\x82\x29\x47\x03\x3b\xa6\x08\xa7\x3d\x77\x0a\x4f\xc4\x3b\xe7\xd3\x4c\x8f\xbf\xb3\x8e\xc6\x78\xeb\x6e\x33\xbe\xf7\xf1\xf2\x6e\xf3\x93\x78\xc3\x07\x06\xdf\x65\x50\x14\xbe\xab\x60\x5f\x21\xed\x40\x9c\x82\x35\x2e\xa4\xda\xcd\x2d\x22\x6a\x95\xb1\x6f\x93\x17\x42\xd6\xcf\x03\xb6\x29\x3c\xd8\xc7\x29\x12\xba\x63\x13\x35\xe6\xbe\x3a\xed\x52\xf0\x4e\xba\x3c\x64\x1b\x79\x3d\xd9\xdd\x90\x7a\x75\xb9\x03\xf4\xb6\x74\xb9\x7e\xff\xb0\x49\xae\x01\x78\xee\xed\xfb\x35\xd7\xb7\x9f\xa6\x34\xd6\x8b\x67\x79\x18\xa7\xa2\xf3\xfd\x70\x19\x4d\xd2\x5a\x68\xd8\xee\xc6\x65\x70\xa5\xce\x34\xae\x10\x39\xec\x4f\x51\x3c\x75\xa2\x1e\xb2\x2f\x2d\xa7\xec\x3a\x98\xac\xac\x85\xa1\x75\xec\xfb\xa9\x16\xdc\x3f\xfa\xcc\x1b\x01\x5d\x21\xe6\x32\x9d\xe2\xbf\xb0\x50\x02\x5b\x2c\x4d\x4f\x61\x4d\x78\x8a\x59\x9c\xce\x16\xfa\x21\x1e\xa3\x88\x64\xb3\x48\x36\x8a\xa4\x55\x24\x2d\xc2\x15\x11\x40\x72\x31\xb7\x7a\x17\x0e\xb1\xc6\x22\xf4\x16\x60\xa1\x51\x78\x61\x2b\xf9\x9c\x78\x7c\xe0\x55\x7a\x86\xdd\xb4\x42\x88\xce\x51\x16\xba\x9f\x46\xdd\xcf\x12\xe5\xc6\xdd\x8d\x39\x90\x33\x42\xf6\xd5\xac\x01\xb0\x2b\x98\xd7\x5c\xc6\x0b\x4a\x19\xaf\x28\x98\x2a\x9c\x1e\xa6\x2a\x32\xab\x6e\xa0\xd9\xb8\x63\x23\xce\xaa\xc3\x4b\x85\xd5\x18\x27\xf3\x9c\xc1\x09\xf7\x1c\xa1\xf6\x30\x2a\x7e\xb2\x34\xa8\x2d\xc2\x49\x07\x93\x5e\x07\x94\x5c\x7a\x70\x32\x6e\xa2\xa3\xa6\x9b\xa8\x02\xe9\x54\x6f\x26\xf6\x97\x2c\x8d\xaf\x25\xae\x9d\x29\x96\x23\x45\x5d\x8e\xb6\x21\xa5\xaf\x69\x3b\xad\x4a\xdf\xab\xa8\xa6\x6d\x9a\x55\x09\x6b\x0b\xe4\xa7\x11\x27\xae\x56\x17\xfa\x33\x02\x50\xd7\x38\x6a\x5c\x1a\x37\xae\x22\x4d\xdb\xee\xeb\xc8\xd2\x7e\x59\x89\x14\xa7\x33\xc5\x74\xa2\xc0\x3b\x2a\xfd\x37\x91\x2c\xa1\xe9\x65\x46\xdc\x57\x5e\x87\x94\xdf\x2d\xa1\x96\xe2\x5d\x20\x5c\xd4\xf6\x08\x29\x6f\x93\x94\xd6\x25\x42\x0a\x3e\x88\x2d\xb6\xd0\x40\xe1\xc6\x83\x1e\xbc\x91\xf6\x38\x60\x4d\xeb\x76\x89\xe8\xe2\xa9\x25\x6a\x74\x11\xe4\xe8\xc2\xbc\xf6\xa0\xe7\x14\xa8\x12\x47\x0d\x46\xc8\x90\x1b\x7c\x05\x20\xec\x75\xee\xe4\x57\x12\xd7\xc4\x69\xd7\xa7\x2d\x62\x7d\x9a\xb1\xa1\xbd\x2a\x67\x2e\x51\x55\xd9\xf8\x2a\x91\x11\x75\x30\x69\xda\x12\xea\x11\x8a\xaf\x5f\x36\x40\xf1\x8c\x85\xe1\x7d\xfa\x7a\x1a\xde\x55\x1b\x68\xbb\x18\xff\x9f\x74\xec\x46\x8f\x70\xc2\xc4\xc6\x37\xff\x20\x73\x5b\xed\xa5\x49\xd5\x7c\x12\xad\xaa\xe5\xa4\x9c\x54\x6f\x9e\x54\x27\xd5\xab\x34\xc8\x8d\xad\xd4\x9e\xf8\x1e\x62\x12\xee\xa3\x46\xdc\x29\x34\xb9\x7c\x14\xb8\xae\x66\x2f\x2f\xf5\x1c\xab\x2c\x37\x0a\x28\x20\x6c\x2e\x05\xeb\x69\x7a\x0a\xeb\x09\x24\xfe\x9d\xfa\x2b\x30\xf1\xab\xbc\xac\xb5\xd0\x4a\xdb\xf4\x02\x1a\xfb\x0e\x7c\x1d\x27\xb0\xfd\x7c\x9a\xfc\x6d\x9e\x90\x9b\x9d\x00\x73\xca\x12\xbe\xd0\xd2\x71\x8e\x86\x6b\xcd\xf9\x64\x99\x81\x7a\x48\x0f\x7d\x79\xe8\x40\x60\xe5\xf9\xfa\xfb\xb9\x46\xbc\xd5\xdd\x14\xbf\x6e\xa5\x70\x7c\x90\xbd\x53\x64\xc1\xcb\xc5\x3f\x2e\xb2\x2d\xb9\xfe\xab\xd0\x4c\xa6\x5c\x47\x37\x3a\x77\x79\xd4\x5b\x90\x3f\xa5\xf2\x9c\x58\x80\x77\x82\xdf\xa0\x23\xd6\xd4\x83\x7e\x45\xde\xb6\x6d\x36\x6d\x17\x6b\x27\x51\xef\x4c\x15\x6b\x33\x46\xc1\x1b\x40\x48\xae\x7f\x96\x11\x44\x8f\xc2\x75\x95\x6e\x72\xc0\x2e\xf4\x83\x94\x60\xc0\x70\x7e\xe8\x86\xb8\xef\x67\x54\x22\x68\xb6\xcc\xc3\x37\xbd\x41\xcf\xbe\xc3\x78\x77\x9a\xe3\x53\x24\x3a\xce\x44\x93\x3b\x22\x92\x92\x06\x75\x45\xd2\x8f\xee\x28\x44\x9a\x10\x66\x00\x2d\x57\x99\xd6\x0d\xd8\x95\x95\xa8\xa1\x5b\xd7\x53\xd0\x7b\x8f\x5b\x0d\xf8\xde\x99\xa4\xfa\x7f\xbf\xf0\xff\x57\xb2\xff\x27\xf4\xb6\x49\x32\x00\xf8\x61\x3d\x2f\xb7\x1f\x42\xe0\x7f\x2e\x6e\x47\x76\xad\x97\x0e\x17\x2a\x29\x76\x33\x5a\x1c\xa0\xec\x85\x0a\x41\x6f\x49\x5b\x85\x95\x8f\x5e\x4f\x15\xe6\xfa\x8b\x8c\x02\x91\x7a\x86\x28\x81\x41\x7c\x54\xac\x01\x78\x8b\xfd\x9e\x3a\x1e\x5a\x7f\xe6\x50\x3a\xb9\x4c\x4d\xbb\x56\x1d\xab\x16\x71\xd3\x9c\x1b\x76\x06\xb6\xdb\x6f\x43\x71\xc9\xb8\x8f\xa1\xd6\xe1\x53\x06\x30\x3e\xf6\x49\x11\xc6\xe7\x55\x97\xaa\x30\x91\x24\x47\x57\xee\xa6\x4e\x68\x35\xcb\xc2\x76\x19\x18\x80\xc7\x7d\x41\xc4\xec\xf1\x5f\x5d\xa1\x2e\x0e\x20\xcc\xe6\xd2\x44\x0b\xa3\x34\xd1\x02\x4d\x73\x3c\xbf\xa2\xc3\x99\xb1\xbd\x5d\xbc\x43\xa0\xe0\xe8\xef\x13\x69\x06\x27\x0b\x12\xdc\x9f\xc3\xd8\xbc\xbc\x4c\x4b\xd2\x7f\xa2\x08\x33\xc1\x3f\x8a\xbb\x49\xcf\xf9\x42\x31\x1a\x9e\x27\x3f\x21\xb8\x1e\x59\x26\x6e\x9d\xcb\x3b\xcc\x9f\x4e\x90\x63\x3a\xdc\x1f\xda\xae\xee\x31\xad\x7b\x15\xc6\xf1\x96\x2b\xc2\xc7\x11\xa6\x7b\x32\x2d\x91\x7b\xcd\x6b\x8f\x7a\xa6\x00\x98\x26\x40\xe8\xe7\xf1\x5a\x75\xb6\x27\x36\x7e\x4d\x6b\xc7\x0b\xa3\x68\x35\xfe\x0d\xe6\xfe\xf6\xb0\xf5\x34\xf8\x05\xec\x54\xee\xf1\xa0\x93\xcb\x20\xf5\xa4\x3e\x8a\x67\x48\xfe\xfb\xd2\x88\x01\x42\xc0\xde\x58\x1c\x6c\xb1\x34\x1c\x8b\x4d\xbc\xe6\xdc\x58\x6c\x7a\xef\xc7\x30\x29\x89\x5b\x6d\x31\xb4\xd0\x61\x71\xdd\x3a\xea\xd1\xc7\xcb\xb4\x21\x75\x2f\xb5\x3f\xf9\xf2\x2c\x65\x8f\x7c\x5e\xa4\x05\x9a\x98\xdb\x70\x32\x26\x71\x8d\x29\x86\x6a\xfd\xbb\x90\xb0\xb9\x4e\x2b\xe1\xf5\x14\xad\xb2\x07\x5d\x2b\xb5\x2c\x22\x9f\x38\xc1\xe2\x08\xab\xf4\x96\x14\x59\xe9\xff\x7c\x1c\x65\xc9\xe3\xe1\x15\x29\xc2\x8d\x34\x65\x3e\xee\x26\x87\x94\x5d\xcb\x2e\x86\xce\x4d\x9a\xe2\x9f\xa3\x61\xc4\xe0\x25\x3e\xb9\x4e\x8d\xed\xfe\x2b\x4a\xb7\xd2\xfd\xd0\xa6\xf8\xf3\x05\x06\x76\x10\x99\xb1\x7e\xe1\xb7\xae\xa1\x76\x64\xd6\x89\xe1\xfc\x89\xab\xeb\x9d\x22\x7d\x1c\x6e\x0d\xbe\x11\x37\x8f\x14\xa2\x2d\x02\xb9\xb6\x2d\xa6\xed\xb1\x8a\x1a\x74\x7f\x9e\x2c\x1f\xa6\xb1\xfa\xbe\xa2\xb0\xb0\x37\x1d\x62\x91\xed\x42\x1f\xd4\x11\xf9\x02\x18\x6c\x78\x7b\x83\x8e\xd1\xc8\x08\x82\xef\xb7\x7d\x0f\xa3\x1c\xd8\x9b\x4c\x4b\xce\x2f\x4b\xd5\x48\x0f\x17\x20\xb1\xe4\x2c\x13\x9b\xec\x85\xa2\x19\x75\x57\xd3\x2a\xb9\x4c\xec\xe2\x90\xb2\x8d\x8e\xd8\x9a\xe2\xff\x23\x98\xf3\x04\xf3\x83\x82\x79\xa1\x80\x3e\x11\x38\x11\x11\xa6\xde\xb7\x4e\xab\xf7\xa1\xc9\x32\x20\xbd\x69\x9d\xf6\xb1\x89\xee\xc9\x32\xfa\xa9\x10\x2d\xd8\x2f\xee\x30\xfd\xda\x48\x2d\x58\xa8\xdd\x8b\x87\xb6\x4b\xa7\xae\x53\xfb\x0e\xde\x43\xed\xb4\x08\x14\xd6\x57\xab\x81\x02\xe8\xa0\xed\x88\x58\x68\x4f\x2e\x51\xa3\x57\x37\xb5\x13\xc2\xd3\xa6\xf8\x51\x4b\x65\x20\x9b\xfa\x3d\x1d\x96\x7c\x63\x53\x43\xec\xca\xf1\x72\xd7\x88\x4f\xa0\x4c\x17\xed\x2a\xa6\x76\x15\x01\xaf\xad\x5e\xb6\x88\xa8\x2f\x20\xea\x57\xc4\x63\x07\x3d\xaa\xd5\x55\x37\xd5\x71\x8d\x66\x9c\xbe\xa5\xb6\xee\xa5\x08\xf9\xd0\x25\xd0\x80\x72\x6a\x16\x45\x9c\x00\xd5\x09\x0d\x5a\x84\x2a\xde\x17\xb7\xbf\xbe\x5b\x4b\xaa\xb0\x08\x55\x64\x91\x2a\x0c\xaa\x2a\xba\x5d\x13\x59\x15\xfe\xd4\x4a\x3a\x5f\xb8\x93\x5d\x17\x1f\xd4\xf8\x93\xde\x12\x56\x39\xeb\x6a\xe9\x30\xc0\xf3\x7e\x33\x4e\x51\x36\xfe\x17\x62\x96\xf9\x27\x82\xc1\xe1\x27\x45\xaa\x5f\x96\x46\x81\xbf\x04\xa7\x95\x5a\x15\xf2\x8b\xb0\xb0\xb3\x53\x84\x75\xc0\x9f\x7a\xa4\x91\x3c\xe2\xf1\x4a\xad\xbb\x1b\x39\x4e\x8e\xfc\x72\xea\x48\x92\xc7\xa5\x35\x80\x6e\xe3\xe4\x84\x5c\x41\xaa\x4d\xf2\xba\xa4\x33\x80\xd0\x0f\x3c\xdb\x0b\x95\x21\xcf\xf6\x2b\xf9\xb3\x83\xea\x21\xfb\x68\x21\x71\xb8\x97\x1f\x61\x50\x37\xba\xdb\xc6\x4a\xa9\x67\x0b\xa9\x43\xbd\x21\xa9\xc2\xc5\x38\x40\x2e\xf8\x46\x20\xf9\xaa\x01\x0f\x4f\x4e\x24\xae\xbd\x3c\x86\x68\x4f\xf3\x6a\x2d\xd7\xa1\x8a\xfa\x44\xd4\x76\x63\x8d\x5e\xd4\x99\x42\x54\xe2\xda\x3a\x12\x90\xb9\x0e\x0f\x6b\x9a\x52\xd7\x42\xd2\x76\x0a\xba\x42\x7f\xe6\x35\x34\x4c\xa9\x9b\x1b\xe4\xb8\xdd\xdc\x18\x52\x12\xdd\x84\x69\x12\x0d\xbd\x99\x1a\x0a\xc1\xcc\x4e\xb1\xb9\x3f\x2a\x4e\x89\x56\x35\xa8\xae\xe0\x83\x31\x72\xe9\x5f\xd4\x28\xd6\x01\x7f\x51\xb3\x10\x7a\x11\xca\xa0\x9d\x12\x51\x3e\x34\x46\xaa\xde\xd2\xa8\x73\x76\x1b\xc6\xc8\x5e\x3d\xda\x40\x2e\xfb\x02\xaf\x66\x4d\xa1\xc3\xbd\xcc\xec\xc5\x64\xdd\x7c\xf2\x09\x71\x98\x68\x8a\x55\x34\x45\x08\xfe\x91\x6e\xe9\xac\xdc\x1e\x92\x9c\xaa\x4a\x2e\x10\x92\xe3\x74\x92\xb5\x22\xeb\x85\xc8\xc7\xe8\x98\xf3\x4b\x1b\x1f\x05\x1c\x82\x4d\xcb\xda\x66\x13\x7d\xd8\x02\xef\x54\x25\xae\x7d\x58\x1c\x12\xcf\x41\xf2\xdd\x66\x7a\xf1\x16\xd8\xd6\x20\x5b\x93\xb9\xf5\x19\x71\xd6\xfb\x7b\x30\xa9\xfe\x72\xd2\x5e\x60\xdb\x79\xdc\xf3\x95\xad\xc1\xa4\x86\xcb\x69\x6a\xe2\xa9\x47\x99\xad\xf1\x7b\xaf\x1d\x24\x95\x93\xa6\x52\x13\xd6\xc0\xca\x86\xb3\xb4\xfb\x9a\xd0\x53\x40\x78\x73\xef\x7d\x94\x52\x7a\x9e\xd4\xb4\x11\x6a\x7a\x36\x4e\x9c\x28\x23\xee\x25\x5c\x15\x33\xc4\xed\xcd\xb7\x03\xfb\xcf\x13\xb7\x55\xa0\xa9\xb0\x1e\x5e\x4e\xe6\x6d\xf1\x0f\x8e\xbd\x1c\x85\xd3\x5c\xd7\xde\x5d\x05\x43\x6d\xeb\x49\xde\x34\xc9\xe9\xd2\x5a\xda\xf1\x73\xa5\xe6\xae\x58\x43\xe8\x0b\x6b\xf5\x96\xb6\xcd\x49\x96\xe6\x29\x83\xfa\x87\x67\x90\x95\x9d\x0d\x49\xdb\x7d\x42\x21\x03\x57\x13\x5f\x7e\x98\xd8\x7f\x9e\x2b\x74\x72\xb3\x2a\xfe\xf1\x7a\x29\x57\xf4\x64\xdf\x14\xd9\x93\xfe\xe7\x11\x26\x98\x34\x03\x2a\x3a\x74\x11\xd0\xbe\x5a\x4f\x22\x5f\xbc\x42\x2b\x72\x8e\x2a\x6a\xb7\xa8\xb1\xde\xa5\x6f\x69\x37\x6e\xa9\x09\xe4\x2f\x9b\x42\x2d\x6d\xa4\x6a\x02\xdb\xce\xc5\xe9\xf6\x8d\xb8\x13\x3d\x78\x7f\x05\x2b\x95\x54\x44\x8e\xcd\xd2\xb0\x6b\x94\x76\xf7\xe1\x4f\x35\x89\x46\x5c\x19\xd6\x88\x37\x47\xcb\x46\x4c\x13\x8d\x38\xbe\x58\xdf\x88\xd5\x15\xd4\x08\xae\xcd\x33\x0c\xda\xf2\x5d\x3a\xb5\xa5\x87\x68\xcb\x08\xec\x88\x7f\xf0\x45\x40\xd7\xb6\x44\xac\x5b\x7b\x56\x91\xb0\x3e\x57\x68\x0f\xce\x16\x8e\x16\x3a\xcc\x1f\x2d\x67\x54\xf5\xaa\x90\x17\x43\x1d\xde\x98\x2e\x75\xd8\x3a\x9a\x6f\xa3\xc6\x56\x90\xf0\x13\xe5\x20\x7c\x60\x50\xef\x50\x57\x7f\x97\x26\x8e\xbd\x2b\xcb\x95\xb0\xd3\xca\x0f\xca\x95\xd0\xa1\x74\xe6\x90\x06\x1a\xd9\x1a\xba\x9d\x90\xba\xa5\x5c\x8d\xa4\xdf\x1a\x25\x57\xce\xed\x82\x32\x43\x50\xae\x5c\xad\x92\x3c\x44\x24\xb4\xf1\xec\xfc\x64\x7c\xa4\x34\x0a\x62\xad\x11\xac\xcd\x62\xd7\x7c\x2a\xe9\xbf\x83\x93\x71\xda\xb1\xe2\xc9\x78\x13\x85\x6b\x9a\x93\x71\xdc\xd9\x82\x2f\xf9\xb5\x0c\xd4\xf3\x49\xe8\x7c\xbc\x55\x73\x12\x41\xdb\xd6\x96\x73\x14\x79\x3e\x6e\xf5\x8f\xd9\x07\xdd\x0a\xc8\xd3\xe8\xd4\xdb\x56\x51\xe7\xef\x2d\x53\x7b\xd6\x7a\x8e\x12\x3a\x0e\x3f\xeb\x2a\x1a\xb1\xe7\x2b\x55\xf4\x63\xe7\x88\x89\x2b\x9f\x7f\xc0\xf1\xaa\x57\xb1\x37\x10\x33\x9d\x89\x08\xdc\xc5\x21\xdc\x52\x81\x3b\xa6\xd6\x3d\x3d\x84\xbb\x44\xe0\xd4\x53\xf2\xd4\x6f\x57\xa9\xb8\xa9\xda\x06\x9d\x19\xe2\x39\xe7\x1c\xf1\x84\x26\x3f\x5f\xf4\x0d\x58\x4a\xff\x6a\x1a\xa7\x26\xba\xdb\x71\x13\x0e\x33\x3d\x64\xed\x1d\x2a\x4e\x3f\xbc\xa1\x72\x98\xef\x0e\xbc\x1f\x90\xb8\xf6\x29\xe1\xff\xda\xea\x89\xd5\xb5\x92\x0c\xeb\x06\x07\xca\xfe\x94\x77\xc1\x2f\x9c\x1d\x3a\xaa\x19\xbc\x8f\x76\xd8\xfb\xc1\x27\x82\x03\xbe\xb7\x86\xe2\x58\x7a\x3c\x79\x3f\x88\xa6\xc3\xa0\xa6\xde\x8f\xe8\x8a\xe9\x96\x45\x53\xef\x9b\x9c\xe1\xc5\xe2\xa8\xa4\xf7\x8e\x0a\x4d\x31\x04\x32\x50\x74\x4a\x0d\xdf\x32\x7d\xa1\x45\x98\xc9\xb9\xab\xf8\x71\x8b\x0f\x84\xfd\x3b\xf8\xee\x64\xfc\x6f\xa5\x6a\x3c\x14\x3a\xe6\x3f\x26\x33\x0a\xc5\xa8\x34\xff\xe9\xd1\x09\x1a\x79\x4b\x70\xa7\x65\xca\x9d\x68\x57\x89\x6b\x5e\xf9\x43\x73\x90\xc3\x26\x79\xd5\x4a\xbd\x49\x06\x93\xd6\x50\x35\xaa\x31\x36\xfa\xe9\x14\x86\x38\x82\x49\xde\x52\xd2\xdd\xd7\x2b\xd0\x96\x1e\x6d\x11\x83\x18\x4c\x9a\x85\xc5\x68\xb9\x3f\x0b\x15\xff\xb0\x8a\xc8\x86\xd2\xbd\xb2\x6f\x83\x49\xe3\x00\x6f\x4e\x39\x0a\xcb\xd1\xf3\xb8\xea\x24\xae\x7d\x56\xd0\xbd\x8a\x74\xbb\xe9\xe6\x14\xaf\x46\x78\x2e\x66\x6d\xa2\x12\x5e\x90\x80\xfd\xc7\x12\xe1\x38\x86\x8e\xe4\xa5\x44\xdc\xdd\xa0\x72\x3a\x89\x3f\xe8\xa9\x84\x85\xc9\x5b\x8e\xf1\x1e\x8d\x7c\xaa\x67\x39\xb9\x85\xa7\x4a\x42\x31\xa4\x73\xb9\x76\x75\xfa\x52\xac\x4e\xaf\x8e\x50\x9f\xd3\x5e\xa9\xae\x4e\x41\xb9\x3a\xc5\x9d\x2f\xfd\x51\xd6\x48\x81\x48\x72\x97\x90\xd9\x54\x96\x08\xff\x24\x6e\xd3\x41\xc1\x25\x25\xe2\xc6\x24\x8e\xc5\x76\x39\x28\xf2\xb6\x4c\xfc\x5b\x8b\x94\xb0\x9b\x30\x69\xba\x9b\x30\xab\x47\xe8\x6f\xc2\xa8\x37\xf4\x36\x2d\x0a\x79\x36\x7f\x66\xa1\xb8\x67\xfc\xd6\x72\x9a\xcc\x1f\x2e\x52\xe7\x48\xec\x08\xe9\xc8\x2f\xba\x92\x66\xf2\x26\xa7\xd6\x15\x1d\x3c\x2b\x34\x25\xfd\x23\xff\xb5\x88\x71\x1f\x74\xea\xd2\x32\xbf\xa4\x79\x92\x3a\x71\xb9\x5a\xd5\xe6\xb3\x64\x55\xe7\x2f\xd7\xd6\x51\x7f\x96\x38\xc5\xf4\x89\x73\x33\x8c\x94\x7e\x16\x77\xda\x4f\x5f\x1e\xd9\xf5\xb1\xaa\x43\xf7\x04\xcb\xf4\xf7\x04\x0f\xd3\xe9\xeb\xd1\x90\xcf\x3b\xac\xf1\x79\x58\x4d\xe0\x83\xe1\xaa\xcf\xbb\xd0\x9f\x71\xd8\xe2\x7b\x41\xdc\xeb\x4c\x7d\xf0\x4a\x0a\x4b\xf0\xfe\xf7\x70\x6e\x18\x16\xa7\xd7\x91\x7a\xdc\x15\x12\xfb\xda\x70\xbe\x63\x85\x07\xab\x19\x74\xaf\xe4\x26\x31\xad\x4c\xeb\x5e\xa1\x88\x64\xe4\x55\x76\x9e\xb7\xf8\x94\x91\x79\xed\xf7\x9e\x3a\x50\xcc\x6b\x34\x09\xc6\x9e\x49\x67\x46\x3f\x08\x59\x2b\x87\x8b\x27\x97\xc8\x89\x01\xe3\x58\x42\x7e\xad\xde\x45\x14\x77\x99\xc7\xdb\xc9\xfa\xd7\xad\x20\x4e\xde\xfb\x4c\x1b\x2e\x9d\x1f\x30\x00\x6b\x4f\x22\xd2\xf0\x59\x7d\xc7\xf1\xed\x80\xe0\xab\xd6\x29\x4f\x89\x7b\x87\x5f\x9f\xd4\x4c\x69\x71\x56\x99\xfa\x70\x5d\xfb\x29\xfd\xef\x85\xaa\xaf\xa6\x29\xfd\xb0\x38\x9f\x0d\x90\xd7\xfc\x80\xbd\x08\xe5\xe2\x47\x2c\x54\x3a\xbc\x5b\xb8\x6f\x98\x30\x54\x68\xfe\xbb\x62\xa6\x5d\x8b\x6a\x4e\xb2\x2e\x94\x0f\x9e\x1d\x18\x16\x32\xe5\x82\x3c\x4b\xd3\xe0\xb7\xa0\xda\x17\x13\x69\xde\xed\x0f\xf4\x84\x5e\xbe\x18\xc3\xc0\x1f\xc3\x48\x0f\xad\x34\x57\x53\x73\xaf\x24\x9b\x8e\x59\xa8\x1a\xda\xf8\x61\x6c\x68\x99\x4f\x09\x92\xd3\x97\xf1\xd9\x54\x66\x21\xdd\xd6\x8e\xb7\x5d\x45\xb7\x75\xf6\x17\xf3\xb3\x7f\x49\x19\xa2\x51\x4d\xcb\x54\xc3\xf4\xa7\x1e\xab\x0b\x59\x29\xed\xd5\x9a\xc5\xf3\x61\xa1\x5b\x1d\x73\x61\x6b\x96\x99\x07\x42\x14\x6f\x2f\x98\xf6\xa7\x82\x1b\x08\xfc\x62\xa4\x6d\xc8\xc0\x89\x90\x5f\x62\xa4\x86\x40\xeb\xe2\xea\x78\x0f\x70\x34\x30\xd4\x48\xb7\x99\xf1\xa1\xb6\xe9\x57\xf2\xb3\x12\xec\xb3\x8e\x06\x36\x11\xc7\xbb\x60\x68\x07\xba\x11\x07\x3e\x60\xfe\xd8\x50\x12\xf9\xf6\x04\x10\x79\x96\x2a\xd2\x19\x12\xf9\xe6\x50\x55\xe4\xcf\x75\x7a\x91\x53\x55\x91\xff\x08\x89\xbc\x4c\x88\xbc\x16\x45\xb6\x0e\x95\x22\xe7\x2e\x51\x45\xd6\x85\x44\x6e\x6d\x27\xf2\xbb\xa1\x52\xe4\xbf\x42\x22\x4f\x11\x22\x73\x50\xe4\x4a\x55\xe4\x29\xcb\x54\x91\x67\x87\x44\xda\xda\x89\xbc\x55\x15\x59\x15\x12\xb9\x7d\x08\x89\x3c\x99\x02\x22\xcf\x55\x45\xd6\x86\x44\xbe\x3f\x44\x15\xf9\xfb\x32\xbd\xc8\xe9\xaa\xc8\x31\x21\x91\x8e\x21\xe4\x1d\xd7\x2f\x10\xb7\x10\x56\x0c\xa7\x27\xce\x94\x25\x18\x2a\x3f\x84\xf5\xbc\x3c\x44\x7a\xa9\x8d\x5e\x32\xb3\x07\xc3\x04\x07\x0e\x0f\x91\x52\x03\xf1\xaa\x54\xf3\x10\x1c\x6d\x6a\x4c\x62\x63\x3c\x86\x61\x0d\x99\xeb\x16\x48\xbb\xf8\x06\x08\x03\x9f\x0e\xa1\xd2\x45\x58\x9a\xd8\xf8\x05\xed\x41\x32\xef\x3d\x45\xd6\xd8\x94\x39\x1d\x15\x97\xc3\xb5\x37\x7e\xe2\x49\x83\x48\xd9\x4c\xc7\x74\x9f\x07\xde\x1a\x4c\x66\xfb\xaf\x15\xb4\x5e\x7a\x06\x43\xeb\x26\x78\xc8\x1f\x59\x7b\x63\xd2\x7b\x34\x13\x5e\x3d\x98\x64\x0d\x41\x59\xc7\x06\xab\xb2\x16\x21\x6e\xc8\x10\x19\x3f\xdf\xbc\x84\x78\x57\x08\xde\x85\x2b\x04\x6f\x7a\x6f\x9a\x03\x17\x2d\x25\xa4\x4b\x20\x67\x92\xb3\xf9\x35\x30\x58\x20\x73\x04\xb2\x42\x20\xcf\x67\xa4\x42\x60\xe6\xe5\xc9\x78\x7f\x42\x34\xf5\xac\x15\x54\x74\x1f\xaa\xb4\x46\x14\x0d\x10\x45\xf7\x62\xd1\xa5\xa2\xa8\xb7\x28\xba\x15\x8b\x72\x43\xcd\x4d\xc7\xfb\x57\xa2\x8d\xe9\xa2\xa6\xef\xc4\x8a\x11\xb8\xb9\x97\x58\xc7\x04\xb2\x46\x20\xf7\x32\xb2\xae\x17\x49\x2b\x47\x69\xc7\x07\xa9\xd2\x96\x61\x7c\xe0\x22\x86\x8d\x82\xe1\x5f\xcc\x60\x11\xd2\x66\x08\x69\xaf\x08\xe4\xad\x8c\x3c\x4f\x20\x87\x0b\xe4\x09\x81\x5c\xcb\xc8\x3e\x02\xb9\x80\xee\xfd\x24\x59\xfa\x10\xb2\x46\x20\xe9\x2d\xbb\xef\x4f\x41\x07\x78\x99\x70\x14\xf8\xa4\xec\x71\x18\xfc\xff\xd6\xa1\x49\x9c\x86\x4a\x18\x07\x6d\x9c\x47\x6d\x8c\x6f\x85\xd2\x6b\xe3\x7f\xaf\x23\x53\x9e\x6a\x69\x8a\xdf\x4c\xc7\x32\xf1\x2d\xe4\x92\xe3\x73\xc8\xeb\xc5\xbf\x2c\xa0\x9b\x05\xee\x79\x01\x99\x04\xee\x69\x80\x5e\x8d\xff\x0f\x5c\x0d\x60\x34\x1b\x71\x72\xc5\xf0\x0d\x4c\xf4\x58\x0b\xda\x60\x67\x29\xdd\xef\xa2\x3a\x6a\x79\xac\x5b\x51\x16\x04\x47\xbe\x79\xb1\x44\xa4\x9e\xea\xa5\x92\x67\x2f\x6e\xff\x5c\x47\x85\xc5\x57\x04\xfb\x23\x6f\x8b\x7f\x46\x5f\x5f\xa3\x07\x1c\x33\x2e\xba\xe3\x63\x30\xe6\x2a\x37\x5a\x7c\x2b\x9b\xcd\x29\xdf\xe7\xf8\x5f\xf0\x50\x54\xff\x15\x86\x3e\xe6\x75\x0d\x53\x06\x7a\x73\xac\x4d\xee\x04\x0c\x5b\x9b\x52\x8f\x8e\xc5\x31\x1e\x84\xef\x20\x79\x86\x89\xa2\x6f\xb1\xe8\x7c\x2a\xf2\x9e\x69\xf5\x2f\x1e\x60\x8d\xad\x5a\x67\x3d\x6f\xf1\xa8\x43\xb0\x4a\x9b\x9b\x2d\xe7\x65\x0f\x40\x41\xfe\xec\x38\x8b\x61\xa7\x29\xf8\x8e\xf7\x11\x92\xbc\xdd\xd6\xf8\x7d\xe2\xda\xc7\x60\x06\x35\x50\x8d\x10\x17\xfe\x13\x00\x7f\xa3\xa8\xfe\x8b\x94\xf7\xa0\xb9\x5b\xe9\xba\xc5\x7f\x6a\xc3\xef\xa7\x78\x7b\xf9\xe7\xa4\x59\x0d\xdf\x42\xc1\xf6\x43\x03\x1b\x7e\x8f\xf1\xf6\xf7\xcf\x49\xf0\x5f\xd1\xd7\x6a\xf8\x01\x7a\xb5\x45\xa0\xb6\xfa\xe7\x40\x3d\xef\x06\x5b\xbc\xaf\x5b\x1a\x56\x6e\x07\xa9\xb3\x70\x92\x36\xac\xdc\x02\xd9\x6c\x91\xdd\x0a\xd9\x34\x6d\xc5\xe7\x19\x44\xa4\x47\x67\xb8\x56\x9f\xa3\xde\xd4\x90\x31\x62\x60\x62\x23\x1d\x7c\xfa\x6d\xf5\x96\xa6\x39\x16\xbf\x29\xcb\xd2\x14\x57\x6f\x79\xe9\x40\x9c\xa5\x29\xc7\x60\xa1\xe3\xcf\xc9\x2f\x27\x36\xbc\x45\x63\x54\xb4\x2d\xf1\xe9\x69\x59\x0d\xc7\xba\x27\x36\x8a\x93\x35\xf3\x36\xab\x7f\xd9\x28\x73\xca\xc1\xdc\x97\x7e\x8c\x4d\x5c\x7b\x13\x17\x5a\xfc\x16\xa3\x25\xd6\xb6\xce\xe2\xb3\x6d\xf6\x67\xe5\x88\x17\x25\xcd\xcd\xa6\xa6\xb8\x38\x7c\xf8\xdc\x1f\xb7\xfa\x19\xa0\xac\xb7\x4c\x6e\xf1\x2c\xc8\x84\x5c\x96\x67\x2e\x55\xe9\xb7\x6d\xf5\x0f\x32\x40\xe3\x5b\x15\x4f\x8e\x25\x76\xc1\x16\xd3\x0b\xe8\xc8\x20\x3a\xca\x7d\xe9\x6b\x40\x9b\x5b\x20\xfe\x49\xc6\x75\xf5\xe1\x33\x69\x74\xfa\x98\x31\xb8\x78\xc5\x3a\xc5\xdb\xea\xfa\xf4\xd0\xa9\x82\x22\x27\x39\x01\x24\x6d\xbe\xd0\x17\xf7\x85\x29\xb8\xcb\x36\xf9\x84\x6b\x0d\x3e\xf9\x68\x69\x78\x39\xcf\x32\xa5\x68\x63\xe2\x9a\x89\xb4\x79\x04\x05\xf8\x73\x46\xc4\xe5\x1a\x20\xda\x26\x9d\x04\x9b\xa7\xd0\xb8\x24\x36\xee\xc5\xe8\xa1\x61\x57\x85\xee\x79\x01\xb0\xbc\x07\x5c\x64\x79\x59\xf3\xb5\x11\x40\xae\xef\x9d\x5c\xdf\x87\x74\x7f\x29\xf5\x5f\xb5\x18\x2b\x18\x01\x6f\x9d\x7c\xcc\x8b\xde\x2f\xc3\xc5\x6b\xc0\x91\xd0\x9a\x1c\x1c\x79\x72\x1e\xd9\x76\xb1\x44\xfe\xae\xbe\xf5\x28\xf0\xfb\xe7\xa9\xd6\xfe\x55\xb5\xb0\xff\x50\xc9\x30\xd1\x8a\x67\x43\x25\x43\x45\xc9\x56\x59\xd2\xf8\x9e\xa7\x0f\x14\xf7\x15\xc5\xd7\x8b\xca\x7a\x52\x65\xaf\x43\x41\x3d\xd3\x5d\x4b\xe7\xdd\xb8\x12\xcc\x13\xe7\xf6\x65\x74\xb0\x13\x3f\x8a\x3c\x49\x7c\x21\x3d\x14\x11\x2f\xfc\x8a\xc4\x0d\x12\xd0\x46\x81\x3b\x5d\x40\x17\x0b\x5c\x5f\x01\xbd\x11\x43\x50\x4f\x01\xcd\x14\xb8\x38\x01\xcd\x89\x23\xe8\x24\xb9\xa3\xf8\x0c\x81\x3b\x26\xa0\x19\x02\x77\x44\x40\x13\x04\xee\x3b\x01\xa5\x0a\xdc\xd7\x02\x1a\x21\x70\x07\x04\x74\x9e\xc0\x7d\x2c\xa0\x33\x05\xee\x03\x01\x0d\x13\xb8\xb7\x05\x74\xba\xc0\xb5\x08\x28\x59\xe0\x5e\x16\xd0\x19\x02\xf7\xbc\x80\xce\x16\xb8\xa7\x05\x34\x44\xe0\xb6\x85\xb5\xe5\x21\xa6\x14\xb8\x7f\x86\xb5\xe5\x2e\x2f\xfa\xb9\x7f\x78\x85\x9f\xdb\x07\x2b\xe0\xb3\xf8\xe1\xb6\x05\x6d\x33\x8f\x87\x4e\x78\xd0\x74\xc0\x6e\x7e\xce\xf5\x05\x53\x9a\xf1\x45\xbe\x89\xb8\x08\x8c\xc3\xc7\x5d\x06\x9d\x81\x62\x76\xa3\xad\x2f\x3f\x9d\xd6\x07\x98\xbb\x80\x3c\x15\x43\x7c\xdc\x59\x1f\x09\xc4\x13\xc9\x3e\x7d\xa4\xa7\xbe\xf2\x90\x8b\x4f\xf1\x9c\xc0\xf7\x0e\xd7\xb6\x29\xfc\x46\xde\x01\x31\x8f\xf1\xb1\xd8\xc0\x33\x42\x2e\xd6\x96\x71\x3a\xad\xb7\xf8\x10\x08\xd4\x31\x0a\x53\x11\xb5\x04\x93\xc8\x90\x3d\xfd\x70\x25\x39\x99\x24\x17\x2a\xef\x81\x43\xb1\xb9\xbe\xcf\x90\xb5\xfb\xe9\x62\x7b\xb9\xfd\x18\x4e\x21\xd8\xed\xe6\x9e\x65\x36\x5a\xfc\x59\x09\x47\x95\x7a\x4f\xa5\x7f\x7e\x1c\x6c\x19\x03\x3b\x92\x44\x34\x70\x69\xc3\xce\xee\x0d\x5f\x1d\xcb\xba\x36\x2d\x6b\x6d\xd0\x33\x0b\xef\x24\x8e\xfe\xc8\xf2\x52\x5b\x2c\x9e\x40\x8c\xfe\xd8\xf2\xd2\xb7\xb1\xb0\x13\xf2\xfd\x9c\x95\xf8\x34\xf4\xf2\xa4\xe5\xa5\x1f\xc0\xcf\xed\xcf\x3a\xba\x3b\xd1\x73\x7a\xc3\x37\x89\xbf\x7e\x6e\x99\x7c\xc4\x33\xce\x32\xf9\xed\x55\xfd\x2c\xc1\x57\x83\xbb\xbd\x3f\x5a\x82\xcd\x42\x1a\x3e\x39\x67\x6e\x9d\xe9\x8b\xcb\x08\x7f\x35\x62\xe7\x6c\xff\xa4\x0c\x7c\x32\x39\x77\xf2\x7e\xcf\x41\x98\x05\xbd\x6a\x68\x53\xb3\x92\x5e\x6b\xc7\x53\xc0\x99\xbe\x41\x40\x90\x7a\xa2\x9a\x22\x04\xab\x2f\x10\x2e\x20\xe2\x8d\xf7\xa3\x72\xc8\x12\xd7\x5e\x61\xa0\x5d\x5e\xe0\x72\xd6\x0e\x28\x6a\x67\x7f\xda\x6f\xd9\x0c\xbf\xbc\x28\x22\xbc\xaf\x03\xb7\x25\x91\x86\x91\xe7\x68\xe2\xda\x4c\x03\xd7\x75\x12\xb6\xe9\x81\x66\xd2\xce\x27\x9e\x73\x73\x9f\x97\x4a\xb4\xf8\xb3\x8d\xd6\x61\x07\x58\x8f\xde\x01\x28\x75\x55\xff\x90\xfa\xad\xbe\xc3\x6d\xc7\x48\xb4\x17\x6f\x67\x04\xbe\x07\xdc\xa1\x1e\x78\xa6\x1f\x98\xdf\x5f\x11\x27\x15\x1f\xd0\x58\xe3\x1d\x97\x2c\xa3\xe5\x19\x55\xf2\xb0\x16\x39\x3a\xad\x44\x3f\x5c\xd0\x7b\x5e\xbe\x76\xc0\xda\x4f\x3c\xcf\x83\xeb\x04\x82\x5c\xa3\xe5\xd9\x10\xc7\xbb\x50\x90\xe0\xcf\x8d\x6b\x78\xa9\xfb\xb3\x58\x68\x6d\x72\xfd\x96\x3b\xec\x6d\x6b\xac\xb7\x19\xf4\x9f\x93\xf8\xf4\x71\x9b\xef\x44\xee\x4b\xdf\xc5\xcd\x6e\x1a\xf3\xb5\x09\x47\xcb\x46\x77\xc7\x31\x92\xf8\x2a\x91\xde\x98\xc6\x65\x11\x46\xd0\xf4\xeb\xe7\xb9\xfe\x95\x50\x5f\xd0\xd3\x1b\x74\x5e\x5f\x85\xde\xf2\x08\xbe\x49\xde\xf0\x4a\x82\x09\x06\xf4\x0b\x41\x3d\x6c\x67\xae\xe1\xd3\xd9\x4d\x95\x5f\x27\xe0\xa8\x62\xcb\x6c\xbe\x1f\xbc\x9b\xda\xce\xa4\x8d\xaf\x19\xb7\x37\x03\xda\x7b\xe7\x94\x2a\x72\x77\xf7\xf0\xeb\xe2\x0b\xda\x7e\x3f\x4d\xf5\x90\x83\x05\xee\xea\x7c\xb5\xe4\x34\x51\xe2\xe1\x12\x7c\xa7\x3e\xf5\x47\xbc\x8b\xe4\x1f\xbc\x30\x9f\xcf\xfb\x7f\xac\xe4\x69\x28\xa6\x19\x1b\x03\x20\x3e\xac\x24\xe6\xbf\x6b\x99\x1f\x17\xcc\x67\x49\xe6\xc7\xa3\x31\xfb\x04\xf3\xef\x73\xc2\x1f\x87\x21\x47\x60\xf3\xff\x1d\x2d\x75\x1c\xde\xb7\xa9\xa4\xfb\xcb\xef\xcf\x61\x4b\xfd\x20\xd7\x87\x2f\x16\x16\x54\xb2\xf1\x1c\x90\x1f\x08\x08\xaa\xb9\x9d\xc2\xab\x5b\x5c\xc2\xab\xef\x16\x5e\xfd\x0c\x3a\x75\x8c\x5f\x73\x05\x41\xe9\xb4\x19\x88\x5f\x21\xa0\x44\x81\x5b\x22\xa0\xc6\x04\x82\x6a\xe9\x1e\x7e\xfc\x40\xaa\x39\x3e\x86\x22\xd2\xf8\x3f\xce\x26\xe8\x52\x41\x79\x42\x50\xce\x15\x94\xb3\x05\xe5\x01\x81\x3b\x24\x28\xa7\x09\x68\x44\x0f\x82\xc4\x73\x01\xf1\x9f\xd1\xfb\xde\xf1\x3b\x04\xae\x55\x50\x8e\x12\xd0\x6c\x41\x39\x5c\x50\x3e\x27\x28\xef\x10\xb8\x67\x04\x65\x1f\x01\x5d\x29\x28\x13\x04\xe5\xdd\x82\xf2\x4a\x81\xbb\x5f\x50\xfe\x52\x4b\xd0\x7d\x82\xf2\x07\x7a\x98\x21\x7e\x95\xa0\x9c\x2f\x28\xaf\x13\x94\x1f\x0b\xca\x56\x41\xf9\x81\xa0\x34\x0b\xca\xf3\x05\xe5\x12\x41\xd9\x2c\x28\x4f\x0a\xca\x67\x05\xe5\x57\xf4\xd6\x76\x7c\x7f\x41\x79\x89\xa0\xbc\x5f\x50\xb6\x09\xca\x2d\x82\x32\x45\xc8\x3c\x22\x70\xd9\x82\x72\xbd\x80\xf6\x0a\xca\xab\x05\xe5\xbf\x85\xcc\xd6\x5a\x5c\x45\xea\x6a\xc5\x2a\xf2\x32\x78\xae\x67\x4f\xa1\x55\x24\xfe\x7f\x62\x15\xc1\x70\x1d\x4d\x6d\x2a\xa0\x02\x3f\xf4\x05\x27\x90\x40\xcf\xde\xf6\x15\x73\xba\xc7\x63\x06\xcb\xe4\x7d\xde\xef\x0e\xc5\xa4\xbc\x96\xf8\xf4\x4b\xc2\x0c\x77\xaa\x5f\x19\x79\x1b\x98\x03\x7d\x99\xb6\x37\x2f\x37\xd3\x50\x4a\x77\x68\xd1\xc7\x7d\xd0\x65\x75\x53\xb7\x06\xc1\xa4\x2f\xe8\x7e\xf5\xee\x05\x6d\xaf\xf4\x11\x96\x1b\x4c\xfa\x50\x16\x3d\xde\x27\x3c\x24\xda\x1b\x4c\x7a\x19\x70\x26\x58\x55\x1a\x8f\x7a\x4e\xcb\x01\xe3\xf6\x4f\x0d\xac\x47\x99\x0d\xbb\x13\x82\x6f\x78\x0f\xe7\xf8\x0e\x47\x5a\xbb\x0a\xa1\x61\x7b\xc9\xc3\x9e\x40\xcf\x76\x6d\x1f\x5e\xa5\xbe\x81\xf5\x88\x1e\xf7\xf3\x57\x8b\x13\xec\xc9\xc7\x3d\xf8\x82\x42\x8e\x38\x05\x0c\x26\x3d\x8c\x6f\xa8\xe3\x83\x3c\x97\x76\x2c\x31\xad\x9d\x44\x53\xb8\xc4\x23\x15\x2c\xf1\xa2\x2e\x4a\xfc\xba\xb7\x5e\xe2\xaf\x55\x61\x12\xb7\x48\x89\xdd\x3b\x92\xf8\xa1\x58\xb1\x71\x9b\x7a\xa3\x46\xe2\x49\x92\xf8\x68\x95\x42\x1f\x7b\x19\x00\xe2\xe6\x08\x71\xea\xf4\x1f\xb9\xcd\x26\xce\xb0\x22\x46\x01\x6f\x53\x5b\x7f\xc1\xa7\xc5\x40\x72\xba\x94\xfc\x23\x1e\xc2\xc1\xb2\xeb\xfb\x28\x98\x34\x9f\x85\xe3\x4b\xfd\xbf\xd0\x7d\x25\xdd\x0b\x86\xc1\x91\x36\x7c\x07\x3d\x7c\x65\x8d\xb0\x2e\x7e\x80\x5b\x2f\x8b\x78\xe5\xff\x0d\x72\x87\xa9\x6f\x96\x8a\xe7\x9f\x6c\xf2\x96\xf6\xce\x32\x32\x19\x71\x4b\x1b\xdf\xe4\xfb\xb8\x3c\x74\xbf\x0e\x4f\x33\xde\x2b\x97\x4b\xf3\xa1\xbe\xe2\x4e\x49\x73\x39\xae\x13\xaf\x5b\xd5\x2f\x1e\x4c\x47\xb5\xd7\xf4\x92\x4f\x98\x03\xc9\x0b\x25\xfc\x08\xdf\x1e\x7a\xe1\xc5\x9f\xda\x9f\xa4\xbc\x2c\x9e\xa4\xb1\x4d\x3e\xe9\x79\x52\x3c\x9e\xda\x9f\x64\x5d\x05\xb2\x0e\xdd\x83\xaf\x32\x59\xfc\x18\x3a\xe0\x9b\x4b\xa0\xe5\x79\xa2\xcd\x87\xcb\xa4\x0e\x06\x68\x75\x90\x6b\x6d\x7f\x86\x2d\x1e\x1d\x87\xd0\x3f\xfe\x0d\x7c\x73\x9b\x8f\xb1\xd5\xc5\xd3\xea\x3f\x0b\xb6\x8b\x46\xab\xef\x51\x7c\x70\x25\x77\xd8\x1e\x53\xc3\x4a\x45\xa9\x8b\xb3\xf9\xab\x12\x72\xfd\x9e\x11\xb0\x25\xfd\x09\x16\xd8\x86\xc9\x50\x86\xab\xac\xc5\xbf\x3c\xc1\x82\x5f\x51\x30\xa7\x04\x4d\x47\xcd\x4a\x4c\xe2\xda\x77\x29\x9a\x7e\x8a\x9e\x97\xf1\x35\xd1\xa3\x2e\x10\x1d\x88\x4d\x15\x6d\x3b\xb7\x43\xda\x57\x3c\x4c\x14\x6b\xf1\xcf\x04\x0f\x60\xdb\x8a\x0f\x20\xe5\xc2\xfe\x06\xe9\x36\x62\x86\xf6\xa7\x98\x41\xca\xfa\x5c\x7f\xdc\x88\x5c\xbf\x75\x04\xd0\x36\xd1\xe3\x3b\xfc\xa0\x8f\x3f\x75\x64\x19\xaa\xa7\x98\xde\x8a\xdf\xbd\x63\x94\xd8\x9b\x07\xf6\x80\xaa\x09\xc0\xc0\xb1\x05\xf5\xee\xf7\x0e\xa0\x50\xf4\x63\xd8\xc6\x06\xf2\x7a\x71\xcc\x61\xa0\xc0\xe6\x51\x7a\xee\xe7\x19\xfa\xb0\x7e\x3d\xf4\x1a\x3f\x2a\xf3\x82\xe8\xff\x3b\x56\xff\xa3\xf3\x29\xf7\x16\x5a\x63\x2a\x30\x5a\x7b\x2c\x37\xda\x7c\xbf\xda\x7a\x54\x8e\x30\xe2\xbd\x8f\x1d\xa1\x48\x72\xa9\xb1\xe1\x02\x52\xcc\x92\x04\xe0\x43\xa9\xb9\x7e\x73\x32\xd5\x94\x01\x35\xe5\xf8\x67\xc5\x99\xfd\xe6\xb8\x1c\x08\x33\xcd\xf8\x92\xd8\x57\xd4\xdc\xa7\xb0\x2a\x7f\x9a\x69\x07\x06\x5f\x29\x9f\x98\x9e\xc5\xa7\x51\x73\x7c\xfb\x7d\x9f\x58\x1a\x56\x0c\x48\x50\x3c\x03\xfd\xd6\x01\x46\x3a\x28\x7b\xff\xcb\x86\xdf\x82\xa6\xc4\x9c\xf7\x4c\x93\x3f\xcc\x49\x9c\x76\xc4\x70\x3c\xf8\xb6\x77\xaf\x29\xf8\x9a\x79\xf4\x4b\x39\xbe\xd7\x72\x0c\x3f\x99\x7e\xdd\x6f\x06\x13\x1a\xf6\x5a\xca\x6b\x16\x3f\x49\x3e\x14\xe3\x4f\x4b\xfc\xf7\x6f\x16\x7f\x61\x5a\x02\x6e\xb4\xba\xe7\x18\x7a\x62\x0b\x82\x2f\xe5\x4c\xfe\xc8\xf5\xd5\xa1\x52\x0a\x50\x7c\x30\x44\x7e\x18\x1a\x1f\x0c\x11\xbd\x7b\x0d\x43\xe4\x37\x6f\xc1\x3d\xae\xc5\x87\x63\x04\x43\x05\x23\xe3\xc3\x21\xf2\xc3\xc8\xf8\x70\x88\xfc\x30\x32\x3e\x1c\x22\x3f\x0f\x07\x0d\x4e\xae\x0f\x06\x2a\xd7\x17\x37\x02\x6c\xa2\x0d\x77\xce\xd0\x38\xb4\x8d\x7a\xef\xa9\x96\xa6\xde\x77\x26\x62\x9f\x67\xc1\xb0\xce\x4a\x3b\x74\x5a\xae\xdf\x86\xb9\xbe\x90\xa6\x61\x49\x7d\x9f\x7a\x8b\x6f\x7a\xb2\x09\xb5\x64\x68\xf1\x4d\x8f\x33\xc7\xda\x12\x72\x7c\xb3\x12\xac\xf8\x48\xe3\x0f\x01\x7c\x78\x8c\x26\xd0\x9d\xe2\x5d\x83\xfd\x81\xfb\xa9\x84\x90\x43\xe3\xf8\x91\x8c\xfd\x81\xfe\x71\x6a\x69\x61\xa8\x34\x17\xb2\x64\xa5\x74\xce\x77\x04\x6f\x1c\x35\x25\x3d\x89\x3b\xa0\xa6\xde\xab\x87\x0a\x6b\xb9\xab\x87\x88\x8f\xd3\x7a\xd2\xca\x76\x9f\x45\x51\x8f\xdf\x93\xac\xbe\x63\xed\x1f\xcd\x0f\xcc\xed\xa9\x7f\x7b\x1b\x1c\x4b\x2e\xde\x51\x3c\x1e\x88\xeb\x49\x73\xfe\x68\xb6\xd1\xe0\x3d\xc7\x74\x74\x3a\x24\xa0\xbf\xf8\x62\xb1\x08\x88\x80\x5a\x1f\xbf\x8b\x1b\x0e\x81\xe1\x71\xf2\xd1\x2b\x84\xfa\xc7\xc9\x7b\xbf\xc4\x84\x4f\x5c\x05\x7c\xf4\x35\x9f\x99\x46\x4b\xc3\x17\x86\xdc\x1e\xf8\xf4\xe9\x2c\xce\x5b\x61\x94\xda\x3b\x3f\x3a\x2c\x13\xfb\xb2\x29\x38\x4b\xca\x69\x0d\xdf\x1d\xf8\x24\x81\xbe\x79\xd5\x8d\x5a\x99\xa8\x9e\x6d\x05\x47\x36\x83\xe3\xf6\x67\xa7\xf9\x67\x66\x34\x34\x77\x6f\x38\x70\xcc\x32\xba\xc5\x62\xd8\xa7\x5d\x96\x79\xf5\x3c\x99\x72\x34\x10\x13\x27\xe3\x7d\xcf\xd9\x28\xfd\xfd\x04\xf9\xfc\x72\xe0\xf2\x1e\xb4\x9f\xf3\xf4\x41\x05\x86\xd6\x50\x74\x70\x9a\x33\x84\x8b\x2b\xc2\x97\x64\x14\xfa\x68\x6c\xb8\xd0\xb5\x21\xa1\x49\x5d\x12\xda\x3b\x82\xd0\xf9\x3a\xa1\x53\x42\x42\x9b\x13\xba\x22\x14\xfd\xbb\x5e\x68\xac\x4e\xe8\x91\xee\xaa\xd0\xc5\x5d\x12\xea\xd6\x0b\x25\x7f\x10\x78\x38\x26\x5c\xee\xba\x90\xdc\xd3\xba\x24\xf7\xac\x72\x19\xfc\x0c\x83\x1c\xee\x57\x21\xfc\xf1\xc5\xca\x42\x5c\x5f\x76\x88\x97\x5d\xa1\xfc\x8a\xd8\xf0\x46\x1c\xb5\x34\x79\x0f\x42\x7c\x50\x46\x95\xa6\x7c\x82\xd5\x0d\xf1\x9b\x0f\x42\x00\x76\x46\xae\x3f\xe3\x73\xe8\xf8\x05\xb1\x88\x8b\xfb\xdc\x12\xdc\x39\x65\xc1\x41\x57\x1b\x3e\x90\xd4\x0d\x48\x4c\xbe\x6e\xe1\xed\x8a\xb0\xdd\x3f\x69\xf3\xd7\x18\x6d\x8d\xdf\x7b\xe6\xc3\x3a\x13\xa7\x78\xfe\x6e\x4e\x39\x68\xf2\x2f\x50\x4c\x89\xff\xfe\x34\xd7\xbf\x62\x70\x42\xe0\x8b\x6e\xe8\xbc\xad\x83\xc1\x43\x14\x0e\x1e\x40\xf7\xe9\x5f\x0d\xfc\x1e\x8f\x8b\xf3\x2e\x64\x36\x4f\x71\xc4\xb9\xde\x34\x1d\xed\x1e\xe7\xe9\xaf\xdd\xd4\x4f\x37\xae\x4e\xc0\x8f\xce\x47\x89\x01\x64\xfd\xb9\xfe\x19\xa3\x30\x9a\x3c\x07\xdc\x65\x43\x9a\xe2\x19\xfa\x1c\xa9\xc2\xef\x69\xc1\xc3\xd5\xc0\x9d\x50\x91\xef\x23\x24\xc2\xfb\xf6\x09\xae\xaf\xa3\x75\x47\x84\x13\xbf\xe0\x97\x0b\x6c\xfe\x15\x23\xb2\x50\x4f\xb3\x4d\x0d\xab\xa0\x4f\x96\x94\x4f\xcc\xfe\xbf\xe7\x24\xfe\xfb\xe7\x99\x4d\x2b\xfe\x9e\x00\x0a\xfd\x20\x10\x8c\xa7\xb9\x3d\xd3\xef\xf9\x7b\x82\xdf\xf6\x81\x78\xab\xfc\xdb\xc0\xa8\x78\x1c\xd0\xef\x3d\x09\x78\x08\x91\x0a\x3d\x0f\xee\x24\x69\xa6\x29\xc5\x71\xae\x67\x23\x6c\x22\xc3\x22\x32\x0b\x04\xa8\xbe\xe3\x34\x89\x07\x5a\x1a\x9a\x47\xc9\x51\x95\x91\x56\xdb\x2b\xf1\xb8\x06\xb9\xd3\x72\xfc\x25\x19\xd6\xd1\x5f\xe5\x36\xcd\xea\x6e\x79\x11\x55\x46\x84\xc3\xde\xb1\x18\x76\x0b\x9b\xbb\x23\x5e\xbd\x3d\x2b\xce\x6e\xde\x82\x82\x99\x4d\x83\xce\x51\x17\x58\x0f\x16\xec\xce\x39\x27\x8b\x9e\x12\xf0\xed\x8b\x1c\xcd\xe1\xe3\x95\x26\x0c\xe5\x4e\xf2\x41\x42\x2c\x1e\xea\xbe\x66\xf5\xfd\x7c\x28\x16\xcc\xe5\xda\x58\xf0\x3a\xa7\x25\x3e\xdd\xdc\x70\x6c\x1c\x1e\x8d\xbc\x94\xeb\xfb\xdc\x36\xfa\xd7\x43\x07\xf9\xa8\x62\x0f\x3d\x35\xf5\x9b\x21\xb1\xf1\x0d\x5c\xca\x47\xff\x6c\x69\x9a\x19\xcc\x9d\xfc\x4e\x62\xc3\x73\x00\xe7\x36\xcd\x30\xc0\x96\xfb\x02\x83\x65\xf4\xce\xdc\x86\x60\xbc\x77\x00\xec\x39\x5e\x1c\x08\x7b\x8e\x78\x45\xdc\x3c\x39\x35\x9e\x4e\x5d\xad\xe6\xb5\x07\xb7\xe3\x5f\x6f\x78\x16\xff\x1e\x50\x22\xbd\xab\xf1\xaf\x81\xe4\xf4\xfb\xa2\x07\x4c\xc4\x17\xd6\x2a\x47\xf4\xf5\x5d\x8a\xf1\xc5\xa0\x11\x16\x58\xbf\x50\xd6\x7a\xa2\x59\xd9\x9a\xd2\x6c\xf6\x1d\xb3\xf9\x7e\xb2\xfa\x7e\x3b\x94\x11\x41\xd6\x22\x21\x6b\x57\x82\x90\x65\xf1\xf5\x1a\x01\xdc\x53\x54\x6e\x93\x6f\x9f\xcd\xd7\x06\x81\x6b\x20\x0d\x5d\xa4\x1f\x98\xc2\x1f\x05\xd3\x7d\x33\xd0\x24\x8e\x6b\x3e\x21\x1d\xac\x14\x5b\xe2\xd3\x07\xe2\x1d\x81\x91\xfd\x07\xd2\x93\x5f\xd6\x44\xf3\x01\xf9\x64\x04\x2e\x0e\xf3\xe3\xa8\xa3\x60\x36\xc7\xdb\xac\xe2\xfe\x97\x77\x21\x8c\xe3\x70\xaa\x2f\xd3\x84\x0b\x1d\x04\x69\xa7\xa6\x04\x03\xff\x8d\xe5\x4d\x7e\xe6\x79\x25\xf8\x4d\x05\x1c\x8d\x93\x81\x3d\xaa\xf3\x4a\x6c\x0c\x92\xdc\x95\xfb\xb4\x15\xfc\x1a\x8b\x91\x09\xde\xa9\x7e\x95\x34\x5f\x1c\x47\x2f\xb1\x80\x21\xec\x05\xcc\xac\xa6\xd2\xb4\x38\xe8\xf2\xf2\x01\xa1\xdb\x23\x81\x5d\xb1\xd4\xa6\x41\xa0\x83\x7d\xfc\xce\xc4\x9b\x28\xea\x79\x0a\x72\x60\xe0\x57\x1d\x3a\x13\x3a\xd6\x67\x80\x42\x21\xe8\x6e\xf3\x9b\xf1\x62\x50\xbb\x01\xb8\x8f\xb9\x9a\x81\xeb\x79\xe4\xba\x5a\x72\xe5\xc2\x5c\xd9\x87\x4f\xe0\xf5\x87\x0a\x03\x67\x40\x27\x67\x73\xdb\xbc\xe3\x0e\x75\x4f\xf9\xc4\x3a\xf9\x1b\xef\x48\x7f\xd1\x9b\x10\xb8\xb4\xe6\xf8\xcd\xcf\x5b\xfd\x36\x10\x92\xfa\xd5\x19\x34\x1a\xdb\x52\x9a\x03\x4f\xd0\xb3\x5c\x0b\xb6\x41\x67\x73\x13\x73\xf0\x9b\x26\xc3\x16\x09\xab\xc4\x9d\x4b\xe0\x75\xf1\xb0\x6b\x6a\x31\x88\x0e\x90\xef\xdd\x17\xf6\x71\x43\x1c\x2c\xab\xbf\xbb\x39\xf1\xe9\x04\x18\xda\x86\xdf\x92\x3d\x8b\x76\xd3\xdf\x47\xb1\xfa\x62\x72\x27\x1f\x76\x4f\xb1\xfa\x7b\xcf\x87\x69\x61\x4e\xdc\xd1\xd7\xdc\xf8\xde\xb2\xf3\x6c\x25\x1f\x67\x1f\xcd\xb9\x35\x33\xce\x63\xcc\x0d\x36\x03\xd5\xe2\xe6\x9e\x3e\x43\xed\xb9\xa6\x86\x97\x5b\x73\x0c\xad\x90\x1d\x6a\xf5\x23\xab\xeb\x4d\xcb\xe6\x5c\x03\x50\x74\x07\x45\xff\xe3\x25\x28\x49\xbc\xb9\xd9\xd8\x02\x9b\xe1\x66\x51\x6d\xe2\x29\xa1\x5b\x5e\xb0\x1c\x0c\x44\x35\xdf\x6b\xe0\xf1\xdc\xcf\x37\xa4\xf2\xc4\x7d\x93\xf0\x35\x5a\x7e\x54\xe6\x78\xc0\x6d\xe0\xd0\x77\x33\x8e\xd3\x94\xf8\xcf\x8a\xf1\xe9\x1f\xfc\x68\xdb\x0e\x45\xdc\xcb\x0d\xf4\x8d\x21\x12\x6f\x1d\x9e\x1b\x5d\x4c\xbb\xa1\xe0\x54\x7e\x14\x47\x7d\x9f\xc1\xd6\x74\x95\x01\xc3\xa4\x07\x0c\xf2\x61\xd2\x27\x2e\xc6\xd7\xfb\x98\x88\xbe\x8b\xf8\x9b\x78\xd6\x77\x4d\x31\x6d\x52\xdc\xf3\xe4\x8e\xea\x7a\x0d\xe5\x6e\xf1\x05\x45\xfe\x76\x0c\xc4\x5f\xf4\x12\xba\x3c\x26\x56\xa7\x48\x70\xe4\x23\xd0\x84\xe0\xc8\x7f\xd1\x75\x1b\x5d\x1f\xa7\xeb\x93\x74\x7d\x9a\xae\xdb\xe9\xfa\x2c\x5d\x9f\xa7\xeb\x0e\xba\x36\xd3\xf5\x65\xba\xee\xa2\xeb\xab\x74\x6d\xa1\xeb\xeb\x74\x7d\x93\xae\x6f\xd3\xb5\x95\xae\xef\xd1\xf5\x03\xba\x7e\x48\xd7\x7d\x74\xfd\x98\xae\x9f\xd2\x75\x3f\x5d\x0f\xd0\xf5\x4b\xba\x1e\xa4\xeb\xd7\x74\x0d\xd0\xf5\x5b\xba\x7e\x47\xd7\x1f\xe8\x7a\x98\xae\x47\xe8\xfa\x0b\x5d\x8f\xd2\xf5\x18\x5d\x7f\xa7\xeb\x09\xba\x9e\xa4\xab\x92\x85\xd7\x18\xba\xc6\xd1\xb5\x1b\x5d\x13\xe8\xda\x93\xae\xbd\xe8\xda\x87\xae\x7d\xe9\xda\x8f\xae\xfd\xe9\x7a\x3a\x5d\x07\xd0\xf5\x4c\xba\x0e\xa2\xeb\x10\xba\x1a\xe9\x3a\x9c\xae\x23\xe8\x7a\x36\x5d\x47\xd1\xf5\x5c\xba\x8e\xc9\x42\x0f\x34\xd8\x91\x09\x63\x57\x10\x1c\x59\x04\xe9\x46\xfc\xbb\x2e\x4a\xdb\xd7\xb0\x82\x54\xe0\x9f\xdf\x69\xfb\x14\x73\xc3\x30\xf7\x1e\xe6\x26\x63\xae\x05\x73\x05\x98\xdb\x81\xb9\x2a\xcc\x3d\x89\xb9\x26\xcc\x3d\x84\x39\x7c\x0c\xba\x6d\x0b\xe6\xf0\xbe\x5d\xdb\xcd\x98\xc3\x5b\x00\x6d\xeb\x31\x17\x0f\x06\xd1\xb6\x06\x73\x43\x31\x87\x3b\x8c\x8a\x29\x98\xab\xc6\xdc\xa5\x98\x2b\xc5\x1c\x1e\x6c\xb7\x5d\x8c\x39\x7c\xa9\xa3\x0d\x77\x5f\x15\xff\xc6\xdc\x74\xcc\xb5\x62\x2e\x03\x73\x87\x31\x37\x01\x73\xa7\x82\xa1\xb7\xe1\x8b\x04\x15\xa9\x98\x1b\x82\xb9\x42\xcc\xf5\xc7\x9c\x1b\x73\x3d\x31\x77\x23\xe6\xf0\x59\x9d\x8a\x87\x30\x77\xf4\x24\xe4\x76\x61\xee\x3b\xcc\x7d\x86\xb9\x2f\x31\x77\x1c\x73\xf8\x98\x5e\xc5\x20\xf0\x49\x6d\x6f\x63\x2e\x15\x73\xbb\x30\x57\x88\xb9\x67\x31\xe7\xc6\x1c\xbe\xb4\x5a\x71\x17\xe6\xee\xc7\xdc\xd3\x98\xbb\x03\x73\x6f\x61\x6e\x13\xe6\x0e\x62\x6e\x1d\xe6\xba\xc3\x64\x69\xbb\x0a\x73\x63\x31\xe7\xc1\x5c\x16\xe6\x2e\x27\xc9\x98\x5b\x88\x39\x17\xe6\xe6\x62\x0e\xff\xdc\x61\x9b\x15\x73\xf7\x60\x6e\x1a\xe6\xde\xc4\x5c\x1a\xb5\x19\x73\xe3\x30\x77\x08\x73\x23\x30\xf7\x3b\xe6\xce\xc4\x1c\xae\x9b\x6d\x7d\x31\x37\x11\x73\xdd\xa8\x36\xcc\x9d\xf8\x03\x72\xb3\x30\x77\x04\x73\x97\x60\x2e\x80\xb9\x1a\xcc\xed\xc7\x5c\x03\xe6\x3e\xc0\xdc\x26\xcc\xbd\x8e\xb9\xbb\x31\xd7\x8c\xb9\x6d\x98\x7b\x1a\x73\x2f\x62\xee\x11\xcc\xbd\x8d\xb9\x7b\x30\xd7\x86\xb9\xcd\x98\xeb\x06\xae\xa8\xed\x3a\xcc\x25\x61\x6e\x2d\xe6\x46\x60\xee\x4a\xcc\x4d\xc4\x5c\x2d\xe6\xb2\x31\x57\xf6\x87\x78\x58\x11\x7e\xeb\x37\x61\x40\xb2\xe5\xd6\x5b\x5f\x9b\x4a\xf0\xe1\x4d\x59\xc1\xdb\x0f\x18\x62\x3f\x33\xe0\x47\xff\x7a\xc3\x3f\x17\xbe\x7d\xb4\x49\x11\x7f\x7f\x8a\x7e\x77\x2a\xe2\x0f\x03\x11\xe6\x51\x45\xfc\xa5\x28\xfa\xe1\x9f\x26\x3b\x47\x62\xce\x06\xb3\xc1\xbf\x15\x85\xc3\xa8\x5c\x68\xd0\x08\x28\x02\xe0\x0c\x89\xa9\x34\x88\x3f\x35\x44\x3f\x9f\x41\xfc\x1d\x22\xc2\x3c\xae\xe5\xc1\x1b\xc5\xa7\x4b\xcc\xfb\x00\x64\x48\x4c\x22\x18\x11\x3e\x54\x50\x8b\x98\x74\x00\xce\x95\x98\x52\x00\x92\x64\x73\xea\xe8\x6f\x3b\x31\xa6\xcc\x35\xce\x5d\x53\x6a\x2f\x75\x8e\xb3\x57\x3a\x5c\x1e\x87\x4b\x29\xa9\xa9\x1a\x67\xaf\xad\xad\x74\x8c\xab\xae\xf1\x38\xcb\x9c\x25\xf4\x67\x92\x4a\x1c\xd5\x80\xf4\x3a\x95\x4b\x15\xb7\xa3\xba\x34\x8c\xae\xd0\xe1\xaa\x72\x56\xdb\x2b\x15\xa5\xca\xe1\x76\xdb\xcb\x1d\x4a\xf6\xf4\x69\xde\xea\xd2\x4a\x87\x79\x99\xa3\xc4\xeb\xb1\x2f\xaa\x0c\x15\x15\x54\xd4\xb8\x3c\xfc\xd7\x98\x0a\xc4\x1f\x2d\x1c\xe9\x36\x8e\x1a\xe9\x1e\x6d\x74\xba\x8d\x76\x23\xfe\x29\x34\x7b\x75\xe9\xd8\x4a\x67\xb5\xc3\xe8\xa9\xa9\xa9\x84\x8b\x11\xab\x34\xce\x2e\x30\xce\x37\x16\xb9\x1d\x2e\xe3\x2c\x4d\xc3\xdc\xe3\x8c\x46\x63\xcf\x9e\x45\x58\x6f\xba\x11\x44\x8d\xbd\x84\x5b\xb1\x02\xff\x96\xdf\x0a\x97\xa3\xaa\x66\x89\x63\x81\xf1\x92\xb9\x26\x6b\x91\x79\x45\x6e\x0e\xfc\x0f\x50\x4d\x2d\x31\x2f\xe8\xd9\x13\xd8\xcd\x4e\x4f\x05\x88\xad\x29\x33\x42\xea\x76\x60\x43\x5c\x8e\x2b\xbc\x4e\x97\xa3\xd4\x38\xca\x5b\x5d\x09\xf2\x8c\x2c\xd4\x88\x7f\xfa\x0d\x09\x6a\x9d\xb5\x80\x85\xb6\x01\x0b\xb5\x73\x74\x3a\xc9\xc2\xdf\x58\xfc\x93\xb1\xc6\xb0\x5f\x8e\xd3\x5d\x5b\x69\xaf\x03\x6a\xe0\x25\xf4\x22\x7b\x75\xb5\xc3\x35\x4e\xe5\x91\x15\x50\x3b\xa9\xa8\x10\x24\x6b\xc7\x40\xb6\x21\xc4\x23\x3a\x67\xcc\xcd\x51\xeb\xc9\xa7\x12\x54\x64\x18\xe7\x52\xe8\x21\xb5\xd4\x5d\xeb\x28\x81\x62\x68\xfa\x97\xf5\x9b\xcb\x5d\x35\xde\xda\x2f\xeb\x6f\x05\x01\x21\x99\xa8\x35\xad\x44\xa3\x31\xb7\xac\x43\x56\xa3\x63\x19\xb0\xb8\x8d\xee\x8a\x9a\xa5\xc6\xa5\x15\x8e\x6a\xa3\xd3\x63\x5c\x6a\x77\x1b\x4b\x1d\x95\xce\x25\x0e\x50\xe2\x98\x9e\xc6\xa8\xbf\x1a\x97\xd1\x0b\x2a\x07\x91\x26\xab\x15\x05\x02\x23\xc8\xa4\x41\x77\x18\xed\x95\x95\x61\x1d\x71\x8f\xeb\x40\x14\xea\xab\xc6\xeb\xa9\xf5\x7a\x84\x29\x81\xe1\x8d\x75\x3b\x6a\xed\x2e\xbb\x07\x1a\x8d\xfd\x1a\x47\x43\x94\xef\xa8\xad\x84\x91\xa8\xab\x0d\x57\xaf\x66\xfc\x5c\x44\x11\x1a\x89\x08\x63\xb1\xd4\x09\x4d\x5b\x04\xf6\x20\x06\x16\x2a\xb0\x63\x9d\xae\x90\x6c\x9a\x50\x63\x58\x0a\xf4\x91\x28\x80\xb6\xc4\x51\x51\x53\x59\x8a\x43\x8f\xf5\x99\x4a\xa8\x5f\x1d\x36\xc7\xce\x34\x24\x2a\x65\x0c\x25\x13\x3a\xd3\x44\x58\x6b\xa5\x04\xfb\x12\x7b\xa5\xdd\x09\xf3\xb1\x23\xee\x79\x38\x86\x75\x35\x5e\x63\xad\xab\x66\x89\xb3\xd4\x61\xac\xaa\x71\x81\x8d\x57\xd8\xab\x8d\x35\x30\x29\x41\x84\xd7\x31\x06\xfa\x5a\xea\xaa\xa9\x2d\xad\x59\x1a\x41\x17\x1d\x49\xbf\x08\x04\x97\x80\xa8\x12\xaf\xdb\x53\x53\xe5\xbc\xd2\x21\xe6\x84\x2a\xac\xd2\xbe\xc8\x51\x19\x32\xd8\x6a\xc7\x32\x8f\x51\xcc\xd6\x90\x8d\x4a\x62\x2b\xd1\xaa\xe3\x14\xb5\xdb\xaa\x70\x8f\xd3\x53\xe9\x30\x8e\xaa\xa9\x86\x41\x22\x53\xad\xf2\x56\x7a\x9c\xe0\xc4\xdc\x21\x15\x41\x5f\xb9\xe3\xa5\x62\x88\x66\x53\xed\xf6\x4a\xcd\x88\x08\x39\x1d\x1a\x08\x91\x8c\x33\xe6\x38\xca\xec\x50\x87\x1b\x2d\x1a\x8c\x5c\xfa\x4a\xb0\xf4\x50\x77\xdc\xde\x45\x3a\x81\xed\xa4\x49\x92\x10\x53\x49\x65\x8d\xdb\x11\xa6\x80\x76\x4c\x44\x62\x5c\xe4\xf5\x78\x6a\x58\xaf\x9a\x3a\xf1\x2f\xe0\x19\x67\x99\x6c\xe6\x30\xa3\xb1\x57\x39\xd0\x0f\xda\x8d\x02\x0f\x8d\x26\xb7\x45\xba\xf2\xb4\x53\x6f\x6d\xad\xc3\xee\x02\x0f\x2c\x59\x85\xf6\x70\xa6\x39\x4a\x3b\x30\x01\x67\xb5\xb1\x80\xc4\xe7\xb9\x1c\x65\xe0\x20\x60\x75\x01\x21\xe0\xda\x8d\xe7\x94\x0a\x75\x9d\x63\x2c\x03\xc7\x80\x15\x72\x81\x4e\x1b\xc8\x1d\xea\x0b\x39\xa3\x30\x9f\x65\x32\x8a\x3f\x88\x0b\x0d\x77\x96\x54\x18\x61\x2c\xab\x91\x1d\x1a\x88\x32\x05\xbd\xbe\x3b\x6e\x30\xe1\xca\x1a\xe0\xf1\xd4\x74\x64\xbf\xb3\x2b\x4b\x75\x6c\x21\xe7\x8a\xda\x83\x66\xc8\xf9\x20\xdc\xb3\xa6\xa1\x62\xe9\xd4\xb6\x14\x35\xb7\x88\x16\xc6\x50\x23\xe5\x4a\x84\xfa\xad\x54\xcd\xa9\xc2\xee\x41\x17\xeb\x85\xea\x41\x34\x3a\xdb\x6a\x74\x28\x54\x2f\x89\x1d\x03\x7a\x2d\xa9\xf4\x96\x62\xb7\x9d\x60\x70\xce\x12\xed\x94\x01\x59\xb9\x50\x60\x2c\xca\xb7\x6a\xaa\x46\x88\x86\x9b\xfe\x58\x2d\x0e\x37\xcf\x61\x90\x05\x83\x68\x2f\x8d\xd4\x14\x14\x6c\x1c\x65\xb3\x83\x6f\x77\x96\x2c\x76\x9f\x67\xc4\xe9\x34\x3a\x64\x98\x35\x10\x2a\x54\x7b\x72\x49\x22\x55\xd7\x71\x4d\x76\x8f\xc7\x5e\x52\x11\x5a\x4c\xc3\x46\x3a\x7a\x35\x97\xbb\x01\x2d\x7e\xf3\x5c\x4e\x8f\x83\xd0\x46\xc7\x12\xa8\x1a\x17\x15\xf2\x52\xb4\x8c\x78\x4a\x61\x51\x30\x6a\xa6\x6e\x95\x03\x0b\x66\x15\xd9\xa6\x99\xf3\xb1\x28\x9b\xa6\x49\x7b\xeb\x2e\xf3\x60\xac\x21\xc8\xdc\x0e\xe8\x56\x29\x2c\x3f\x3d\xe7\xc9\xa9\xe0\xc5\x50\x04\xbd\xc6\x12\x58\x5f\xdc\x58\xa7\x98\x6f\xe1\x4b\xef\x18\xa2\x75\x39\xdc\xe4\x04\x68\x7a\xd4\x94\x97\x8b\xde\x72\xd3\x68\xe1\xa0\xee\x80\xd1\x7a\x4b\x70\x95\x82\x08\xc7\x21\x86\xdc\x89\xd6\x0e\x66\x55\xe2\x74\x95\x78\xab\xdc\x1e\x3b\x4e\x16\x92\x59\xe6\x74\xc1\x4a\x5d\x52\x01\xeb\x5b\x89\x47\x18\x8d\x5d\x0d\x53\x2a\xec\xe4\x71\xc0\x52\x1c\xee\x12\x3b\x86\x2a\x20\xa8\xc6\x85\xd6\x27\x8a\x5d\xd0\xa1\xf2\x6a\x70\xbf\x60\x9e\xa6\x6a\x58\xc3\xed\x55\xe0\x06\xc5\x70\x83\x3b\x86\xff\x69\xce\x7b\xdd\x68\x4e\xe8\xf9\x6b\x01\x5a\x04\x55\x2d\x76\xc0\xa2\x26\xa6\x55\xfb\x4a\x2a\x9d\x8b\xc1\x1c\x6b\xd2\xd1\xd5\x5d\x7a\x09\x39\xb9\x9e\xd3\x41\x33\xb4\x82\xe0\xdf\x42\x76\x55\xf1\x0c\x86\xc5\xbd\xc2\xe3\xa9\x75\xa7\x8f\x1f\x5f\x0e\xf3\xc7\xbb\x68\x1c\x44\x7e\xe3\x61\xbd\xaf\xa9\x1b\xef\x61\x27\x39\x56\x28\x12\x97\x4a\x85\x62\x2a\x45\x4c\x95\x5c\x75\xa6\x28\x97\x0c\x5b\x60\x2c\xaa\xc6\x08\x93\x7c\x55\x0d\x68\x44\x17\xfa\x48\x47\x52\xe2\x75\xb9\xd0\x36\x68\xd8\x46\x8d\xcc\x1a\x3d\x06\x15\x0f\xa1\x0a\xf6\xa2\xba\xc6\xe8\xf2\x56\x57\x63\x5f\xb5\xc1\x65\x36\x45\xbd\x34\x17\x50\xef\xe3\x14\xe9\x86\x15\x31\xb5\x15\xfa\x03\xd4\xe4\x8d\x94\x90\x3f\x56\xc2\x96\x27\x85\x17\x16\x45\x44\x15\x8a\xb8\x52\x0c\xa2\x88\x68\x85\x13\x33\x9a\xae\x42\x76\xad\xd0\x85\xdc\x94\xb8\xe6\xe6\x28\x3c\x81\x15\xed\xf4\x52\xd8\x98\x95\x64\xc5\xeb\x75\x96\x2a\x23\x2b\x4b\x15\xd1\x3e\x35\x26\x2f\x16\x6e\xc5\x53\x27\x18\xc2\x41\x8b\xdd\x3d\x8d\x8c\x42\x29\x46\x87\xe2\x9e\x46\x4b\x85\x5b\x19\xa3\x14\xdb\x2b\x97\xda\xeb\xdc\x10\xad\x2f\x35\x55\x82\x0e\xaa\xc1\xc6\x45\xa8\x62\x73\x54\x7b\x11\x1d\x56\x28\x18\x0b\xb1\x6a\xb7\xc2\x2e\x5b\x81\x80\x4e\x19\xbf\xc8\x59\x3d\xde\x5d\xa1\x8c\x2d\x51\x78\xaa\x00\x75\x21\xfe\xb5\x7d\xd2\x57\xa9\xa6\x74\x2e\xce\x58\x65\x49\x5a\xd6\xd4\xe4\x76\xf2\x73\xc1\xc1\x2d\x63\x4d\x66\x83\x23\x5a\xdc\x9e\x53\x90\x8c\xac\xac\xf4\x4a\x15\xb9\x25\x25\xaa\x1c\xe2\x56\xa5\x1a\xc2\x17\xd8\x6d\x28\x59\xd9\xd6\xd9\x05\xe6\x1c\x25\xab\x30\xd7\x66\x9e\x5d\x54\x08\x05\xb3\x67\x15\x9a\x67\x15\x66\x5b\x73\xb3\x67\x22\xc2\x94\x5d\x98\x3b\x7b\x96\x04\xeb\xe0\x37\xd6\x66\x1b\x5b\x5a\x6a\xb4\x58\xd2\xab\xaa\xd2\x61\x3f\x70\xb1\xa2\x86\xb7\x26\x8f\xa6\x2d\x00\xcc\xe0\x11\x23\x75\x28\x60\x5e\x15\x4a\xe1\x9c\x31\xf9\x60\x3c\xb5\x0e\x17\xfd\x55\x6b\xa5\xf0\x2c\x80\x4b\x61\xd6\xb8\x9c\x14\x5c\x28\x85\x59\xc3\x67\x15\x88\xfd\xd0\xf0\x31\xf9\x63\xb2\x01\xb9\xc8\x5b\x9e\xa3\xa1\x98\x55\x20\x36\x50\x90\x31\x79\xf1\x0f\x6d\x57\x3a\xec\x6e\x47\x1e\xec\x3c\x14\xf1\xf7\xad\xed\xe1\xa5\x79\x5e\xa8\x37\x32\xa6\xa6\x56\xfe\xed\x7f\xcd\x9f\x74\x06\xb1\x2e\x97\xbd\x0e\x52\x9b\xd8\xb2\x49\x30\xc7\x49\x6a\xb7\xbb\x10\x98\x8d\xd6\xe2\x28\x2d\x70\x78\x42\x84\x91\xca\x34\x4c\xd8\x06\x47\x89\xa7\xd8\xed\xf0\xe4\xf2\x44\x9a\x6b\x77\x39\x69\x57\xa8\xc5\x2d\xb1\xbb\x54\xb8\xa4\xa6\xb6\x8e\x1b\xef\x72\x78\xec\xce\xea\x30\x60\x5a\x65\x4d\xc9\x62\xb5\x84\xfa\xd5\xbe\xa7\x61\x1c\xa6\x76\xe5\x1a\xca\x7c\x87\xc7\xeb\x62\xf3\x8b\xc2\xd4\x05\x92\xd2\xf6\x34\x6e\x44\xc2\xa0\x42\x50\x01\x9a\x29\xaa\x2e\xad\xb1\xd9\xab\x61\xda\xb9\xf2\x5c\x35\xcb\xa0\x7f\x1e\xbb\xab\xdc\xe1\xc9\x26\x8b\xc8\x4a\x3f\x0b\xb5\x47\xf8\xd2\xd9\xa4\x05\x80\xb3\x6b\x48\x63\x1e\xde\x29\x4b\x13\x81\xcc\xcc\x6a\x70\x30\x33\x1d\x75\x6e\x1b\x38\x06\x28\x02\x04\xcc\x96\xf2\xba\x14\x2d\x2e\x34\x0a\x29\x6a\xb3\xed\xa5\x54\x61\x9e\xdd\xe9\x92\xaa\x00\xa7\x0b\xfe\xce\x11\x5e\x8e\xc3\x50\x8e\x43\x06\xd3\xca\x51\x8a\xa3\xe3\x66\x7a\xb5\xcd\x2a\x68\x03\x65\xe8\x8a\xf2\x79\x03\xad\x2d\xae\xac\xa9\x59\x5c\x54\xab\x23\xcc\xc3\x3f\x1b\x5f\x82\x56\x4c\x53\x03\x8b\x66\x41\x1c\xa5\x88\xbf\x27\x0f\x88\x50\x09\xff\x19\xfc\xda\x3a\x12\x81\x65\x6e\x58\x5c\x84\x63\x5b\x92\x95\xae\x14\x2c\x75\x96\x79\x94\xe2\x42\xcf\xc8\x92\x02\xf7\xc8\x2b\xbd\x23\xc7\x9d\xeb\x86\xe9\x5e\x2c\x8a\x64\x41\xa8\xbc\xd0\x93\x87\x97\x6c\xa5\xc0\xad\x60\xd9\x38\xbc\x28\x55\x68\x61\x62\xaa\x15\x17\xb7\x5b\x70\x84\x66\xa0\x5a\xd0\x6f\xba\x22\x66\xb3\xd3\x3d\xd3\x59\x5d\x3a\xbb\x8c\x9a\x95\x8e\x33\x1e\x03\x5b\xe7\xb2\x74\x5c\x2f\x44\x20\x3a\xdd\x55\x53\x45\xaa\x4c\x0f\x93\x00\xb3\x06\x28\x68\x8e\x03\x31\x8c\x74\xa9\xdd\x55\x8a\x67\x1c\x72\xdb\xa0\x94\xaa\x43\x38\x0f\x96\x49\x61\x18\xee\xf4\x32\xe2\x76\xa7\x97\xc0\xd2\x03\x9c\x2e\x47\x39\xc6\xdc\x2a\x97\xa6\x92\x5c\x58\x74\x43\x66\x40\x8d\x2e\x2a\x9c\x9e\xc6\x76\x04\x3a\x86\x70\xc2\x8d\x44\x0a\x18\xa3\xb7\x0a\xdd\xa6\xe2\xc4\x86\xce\x2e\x13\x95\xa5\x03\x91\xb3\xda\x63\x81\xe5\x77\x1a\x1d\x59\x28\x6e\x08\x39\x1c\xa5\xf3\xf0\x0f\x6e\xd7\xc2\x6e\x56\xe1\x95\xd3\x14\x0a\x11\xdd\x0a\x85\x5f\x52\x4b\x65\xce\x4a\x58\x6a\x50\x91\xd8\x87\x02\xee\x66\x6e\x35\xae\x7d\x68\x90\x39\x76\x8f\xbd\xb0\xc6\x8c\x2a\x9c\x0e\xa4\x0a\x9e\xb1\x20\x25\x96\xa7\x2b\x64\x9e\xd0\x26\xa7\x47\x2d\x83\x1d\x43\x0d\xc6\xb9\xe9\xb4\x00\x6b\x57\x6c\x24\x61\xff\x9b\xce\x8b\x74\x54\x74\x48\xb5\x0a\xf8\x1f\xee\x6d\x59\xfb\x71\xc1\xe5\x04\x6c\xc2\x2d\xf5\x41\x1e\x5d\x0c\x2b\xca\x9b\x4e\x41\x4d\xba\x5c\x0f\xf4\xd5\xd1\x2a\x90\x2e\x03\x87\x74\x0e\xd0\xd2\xf9\x00\x2a\x9d\x82\x07\x18\x93\x7c\xab\xd0\x8d\x8b\xba\xe5\x86\x80\x18\x2c\xbe\xd2\x51\x5d\xee\xa9\x20\xfd\x31\x41\x9e\xdd\x53\x91\xae\x2a\x23\x9b\x17\xba\xd9\x65\x80\x4e\x57\xaa\x1d\x4b\xb1\x27\xa2\x46\xcc\x15\xc8\x5a\x15\x72\xbe\x1c\x7e\x2d\x71\x14\xc2\xa6\x9a\xca\xd0\xd4\xb0\x3c\x5d\xc1\xa9\x8e\x13\x09\x0d\x95\x84\x01\x96\x7c\x19\x6b\x04\xa4\x7b\xab\x16\x39\x5c\x58\x6d\x6e\xb5\xe0\xce\xd6\x84\x22\x5a\xfc\x34\x58\x64\x50\x6d\x55\xb5\xb0\xe0\x42\xf3\x0a\xe4\x21\xcc\xb4\x3a\xd9\x41\x32\x5c\x94\xd1\x2e\x7e\x20\xc9\x10\x98\x68\x11\xb2\x8c\xa2\x26\x4d\x51\xbe\xc3\x0d\x55\xc0\xaa\x16\x3a\x55\xa1\xf2\xd9\x78\x9e\x17\x26\xd3\xe9\x36\x5f\xe1\xb5\x57\x16\xd6\xa8\x1a\x06\xed\xa0\xea\xd1\x8b\xa4\xcb\x90\x05\xd5\xd1\x3e\xfe\x43\xda\x1c\x70\xf2\xe5\xd0\x85\x88\xc3\x9c\x1e\x8a\x05\xc2\x8e\x26\x45\x2f\xa7\xd5\x99\x41\x35\x0e\x50\x00\x1b\x0c\x4c\x00\x10\x54\xc3\x53\x99\xa7\xb0\x97\x47\x82\x8d\x36\x27\x92\x40\x98\x8a\x4e\x88\x9c\x42\xd3\x09\x4c\x2f\x1f\xe6\x0f\xce\x64\x68\xa3\xd5\xee\xad\x2e\x61\x0b\xb1\xe3\xe2\xad\xf5\x18\x5c\x0d\x6a\x5c\xce\x73\xa1\x04\x9e\x8d\xb3\x29\x06\x0d\x2b\x32\xbb\x5c\x35\xa0\xcf\x4a\x12\x4b\x93\x52\xb1\x2f\xb1\x3b\x2b\x69\x7d\x27\x08\xf6\xff\xd5\xa5\x62\x96\x2e\xb5\x3b\x3d\x45\xe0\x24\x2b\xcd\xcb\x9c\x1e\x85\x23\x78\x0f\x1d\xf2\xda\x3d\x5e\xb7\xe2\xae\x74\x38\x6a\xa1\xbd\x85\x10\xb1\xe6\xa2\x56\xc1\x43\x80\x9b\xd2\x0d\x94\x32\xc7\xeb\xf4\xa4\x87\x1d\x83\x81\xb1\xc3\xba\xe6\x70\x89\x85\xd5\xe9\x06\x85\xf0\x50\xa6\xeb\x43\x4a\x6f\xb5\xdb\x59\x5e\xed\x28\xb5\xc2\x82\x8b\xff\x04\x8b\x50\xb4\x49\xae\x64\x9a\x89\x6d\xd7\xdb\x1e\x28\x5f\x18\x14\x4f\x6f\x9a\x68\x34\xfc\x30\x62\x72\x9e\xdb\x4b\x4b\xcd\xd5\x80\x86\xc5\x07\xe6\x4a\xc8\xb7\x92\x3c\x68\x18\x0f\x5d\x1d\xf2\x28\x21\xef\x9f\x43\xd6\xe3\x74\x43\xa3\x9c\xa5\x17\x16\xcc\x9e\x25\xdd\x89\xf4\x76\x9a\x32\xe9\x1c\x1c\x62\x04\x4a\x84\xd1\x16\xe1\x5e\xcc\xac\xba\x3e\x1a\x62\x0e\xf7\xd5\x7d\x89\x33\x7c\x92\x87\xc5\x98\xd0\x70\x59\x27\xf6\xcb\x09\x0d\xb9\xd2\x11\xb6\xd8\x68\x76\xf9\x05\x74\xd4\xc0\xdb\x08\x6c\xb9\x06\x97\x8e\x9b\x41\xf4\xd4\x91\x4b\xdd\xed\x8b\x0b\x1d\x55\xb5\xed\x18\x44\x15\xb3\x01\x8d\x86\x03\xad\x2f\x6d\x47\xd2\x21\x52\xad\x10\xb5\x07\xbb\xa0\xa2\xdc\x70\x34\xad\x5b\xed\xb9\xd4\x62\x77\x3a\x9e\xdc\x40\xd8\x8a\x13\x13\x3c\x31\xec\x75\xf2\x10\x97\x67\xaf\x76\x54\xba\x23\x34\x55\xd5\x86\x09\x8f\x06\xac\x76\x37\x38\x61\x08\xea\x96\xd2\x01\x42\x69\x04\x06\x31\x4b\xf3\x1d\xd8\xd0\x74\x58\x36\xe6\x3a\xdd\x78\xf8\x2a\xb8\xc2\x6b\xc8\x81\x90\x16\xb7\x56\xe1\x6d\xc5\x93\x24\x88\x25\xdc\x30\x5d\x79\x36\x6a\xb1\xa5\x4e\x08\x3a\xc5\xca\x4f\x9e\xa0\xaa\xc6\x13\xb6\xda\xb9\x69\xc1\x74\x2c\x71\x96\x38\x0a\x6b\x16\x43\x13\xf4\xdc\xd3\x61\x36\x17\xd6\x74\x2e\x23\x5a\xdd\x25\x0e\xb0\xb3\xf6\x3c\xed\xfb\x40\x56\x0b\x94\x18\x11\xa3\xfb\x10\x2e\x50\x2f\x30\xc7\xd1\x29\x15\x0a\xc3\x65\xc7\x59\xed\x25\xd3\xc5\x55\x62\x09\x6c\x67\x69\xa5\x05\x1f\x10\x4e\x5d\x12\x81\x32\xdd\x25\xe4\x23\x81\x18\x9f\xf6\x3d\x13\x7a\xe9\xb0\x1e\x47\x44\x95\x14\xd5\xc2\x6c\x0e\xaf\x4e\x4b\x32\x0f\x5a\x3f\x1d\xe6\x9e\xbb\x42\xf8\x6a\x31\x8f\x35\x46\x00\x55\x77\x80\x46\x76\x0b\x6c\xe0\xf5\x3c\xed\xca\x90\xb0\xa8\xba\x22\x02\x69\x84\x52\x24\x9e\x06\x9a\xaf\xa2\xbd\xf6\x92\x76\x2c\x51\x71\xc8\x08\x83\x05\x2e\x37\x32\x63\x54\x1c\x35\x8f\x34\xd5\xae\x79\xed\x4b\x91\x38\xb2\x1b\x02\xfa\xec\x0a\x7b\x75\xb9\xa3\xa0\xc4\xe5\x70\x54\xe7\x41\x8c\x51\xe5\x00\x3b\x76\x47\xa6\x9a\x5d\x52\x52\xe9\x75\xf3\x8a\x14\x0a\x09\x70\xc9\xab\x2c\x53\x60\x6b\x8e\x9e\xb3\x00\xd6\xf9\x12\x0f\x8e\xac\xbe\x60\xa9\xba\x98\x76\x88\xd3\x92\x39\xdd\x62\xa3\xe7\x74\xdb\x1c\x18\x1b\xa9\x3b\x04\xb0\x4b\xe4\x77\x17\xd6\xc8\xdd\x4f\x3a\x2f\x3d\xa5\x50\x16\x6a\x03\xef\x7d\xe5\xce\x55\xbb\xbb\x15\xa8\x6c\x0a\xa3\xae\xc4\x73\x0d\xcd\xe9\x42\xbb\xb3\x03\x6f\xc4\xb8\x46\x4c\xb9\x08\xd1\x4c\x74\x72\x13\x1f\x77\x76\x89\x5e\x9c\x56\xb3\xfb\x0a\xe7\x70\xd0\x8d\x61\x47\x41\x85\x03\xa7\x33\xdd\xf1\x4d\x57\x16\xd5\x39\xda\x1f\xf0\x89\xcd\x86\x66\x6f\x81\xdb\x0d\xa5\xb2\xc6\x5e\xaa\x94\xba\x50\x39\xa1\xd0\xdd\x1e\x61\x89\x0f\x5b\xfc\xd3\xe9\x24\x08\x42\xc5\xd9\x61\xa5\xda\x21\x6b\x27\x2d\xbd\xdd\xde\x40\xee\x2b\x66\x87\xed\x04\x69\x97\x42\xbe\x92\x8e\xd7\xb8\x60\x9a\xdd\x53\x52\x41\x15\x70\x31\x87\x61\x65\xb0\x76\x90\x78\x64\xe7\xdd\x7a\xba\xd8\x77\x49\x81\xa1\x25\x9a\xb7\x01\xb3\xcb\xa6\xd5\x79\x1c\x6e\x5d\x14\x80\x9b\x71\x8e\x69\xab\xec\xcb\xac\x44\xa9\xd9\x1f\xc9\x9d\x02\xb1\xa6\x57\xea\xd1\x8b\x1d\x6a\x70\x5a\xe3\x52\xf0\x5e\x1b\x1f\x3d\x4c\xb7\x2f\x76\x4c\xd3\x8f\x85\xaa\x57\x53\x35\x38\x12\x71\xe6\xab\xc0\xd0\xc8\xe0\x18\xcf\x90\x34\x13\x2f\x54\xaa\x9e\x67\x44\x0e\xb0\x55\xca\xe2\x62\x53\x7e\xb6\xd5\xe9\x71\x14\x17\x87\xf2\xfa\x41\x85\x86\x6b\xd1\xe1\xbb\x35\x81\xcc\x4a\x39\x3f\x2b\x39\x3d\x4d\xc9\x9a\x30\x09\x53\x00\x95\x25\x5c\x34\x27\x54\x24\xb3\xc3\xc3\x9a\x3d\x1c\x30\x25\x13\x27\x30\x11\x48\x50\xa1\x76\x74\x9a\x33\x3b\x20\x5b\x12\xc6\xb4\xa4\x23\x26\x3a\x5a\x43\x9e\x92\x50\x6b\x4a\xba\xd2\x9a\xb0\x0a\xe7\x4c\x9a\x14\xaa\x30\x6b\xe2\x84\x92\x49\xc9\x6a\x59\x87\xd5\x62\x3e\x14\xb7\x0e\x17\x9c\x25\x13\xd2\x58\x5a\x09\x35\x2c\x2d\xb2\x1c\x44\x62\x29\xc6\x2a\xc3\xa3\x36\x39\x2b\x4c\x17\x48\x40\x21\xc4\xf0\x8e\x94\x22\x28\x3a\x53\x1d\xee\x36\x3a\x25\xea\xa2\xa8\x90\x0a\x3a\x23\xcd\x86\xb8\x84\x04\x82\xa6\x34\x3a\x9f\x3a\x71\x82\x5a\x12\x91\x4f\x1b\x09\xa0\xb6\xa6\x66\x2c\xc9\x9a\x1a\x1a\x8a\x0b\x80\x7f\x49\x98\x44\x4d\x41\xc7\xe6\xa6\xd5\x69\xc7\x6d\xd7\xb5\x41\x59\xa2\x99\x14\xa1\x01\xd4\xce\x4a\x1c\xc1\xb3\xc2\xa7\x50\x7a\x68\x50\xd3\x79\x50\x45\x33\xd3\xd5\x76\x97\x30\x07\x1b\xf2\x59\x5a\x9b\x96\xcb\xdc\xf0\x50\x21\x4a\x9c\xab\xce\x4a\x4e\x2f\x5b\x5e\x3c\xab\xe0\x62\x58\xce\x32\x57\xca\xfa\x43\x9d\xe6\x12\x4d\x67\x23\xbb\x13\x4d\xbf\xc3\x7a\x15\x3e\x95\xff\x34\xef\x92\xd4\xf3\xc3\x06\x0a\x15\x30\x29\x8d\x0e\x1e\x59\x49\x73\x50\xa5\xaa\xe5\x83\x7b\x51\x32\xa6\x2e\xab\xaa\x34\x2e\x11\x8f\x3b\x65\x0e\x4f\x19\x97\x3c\xdc\x28\xbd\x6f\xe6\xf0\xa2\xc2\xe9\x63\xd3\x86\x4f\xbd\xa0\x67\xc6\xb0\x9c\xd9\xd9\x85\x17\xe5\x99\x8d\xb5\xf4\xf8\x4d\x5e\xd1\x34\x6b\x6e\xb6\x71\xf8\xd8\xf1\xe3\x71\x3c\x1d\xe3\xc7\xe7\x14\xe6\x18\xf3\xac\xb9\x05\x85\x46\x90\x31\x7e\xbc\x79\xd6\x70\xe3\x70\xbc\x7f\x96\x3e\x7e\xfc\xd2\xa5\x4b\xf9\x41\x2c\xbc\x83\x06\x84\xee\xf1\xa0\x6e\x08\x06\x3c\x75\x56\x10\x36\x16\x18\xc6\x95\x7a\x4a\x87\x43\x35\x42\x7a\x58\x73\xa0\x14\xcf\xc1\x2e\xe8\xd9\x23\x03\x16\x83\x0b\xda\x3f\xb9\x95\x31\x1e\xcb\x01\x2d\xb6\xb2\x17\x8c\x58\x6e\x9e\x6f\xce\x2e\x2a\x34\x4d\xb3\x9a\x8b\xf1\xf9\x81\x95\x19\xe3\x19\xa5\x93\x81\xb7\xab\x70\x9f\xa5\x97\x20\xef\x4d\x45\xe5\x53\x57\x1c\x3d\x67\xe8\x69\xb5\x11\xcb\xf3\xf2\x67\xe7\x14\x65\x17\x52\x13\xd2\x5d\x65\x25\x29\xc9\x13\x27\x85\x6e\xa1\x47\x6f\x54\xd8\x79\x28\x3f\x88\xa6\xaf\xe7\xfc\x71\xc9\xd1\xf8\xf1\xbc\xa8\xbd\x4a\xb4\x8d\x89\x5a\x75\x9e\xbd\x64\xb1\xbd\xdc\x81\xdb\x07\xbd\x04\x53\x5e\x9e\x35\x1a\x5b\xfb\x27\xe6\xf4\xdc\x38\xc2\x51\x1b\x5c\x00\x31\xb8\xdd\xe3\x75\xb5\xab\x73\x2a\xfc\xa2\x31\x45\xd1\x4b\x8a\x9e\xde\x5a\x60\x83\x08\xa3\xca\x5b\x55\x50\x07\x3b\xc8\xaa\x28\x6c\x23\x96\xdb\x4c\xd9\xb3\x0b\xe6\x17\xe7\x98\xf3\xac\xb3\x2f\xb2\x99\x67\x15\x16\x17\x9a\xf2\x67\x98\x0b\xdb\xe9\xca\x5a\x50\x94\x6b\xae\x74\xe0\xf9\x94\x2a\xc5\xe3\xf2\x3a\xc6\x4b\x02\xf2\x70\x85\x2e\x7b\x35\x44\xcb\x2e\x4f\x01\x58\xa9\x0b\xfc\x9a\x4a\xcb\xb6\xac\xd2\x42\x10\xb6\xd4\x6d\x72\x2d\x72\x7a\x5c\x30\xdc\x56\x08\x1b\xdd\x92\x56\x23\x78\xbc\x76\x0a\xcc\x2a\xb0\x78\x21\x20\xc5\x83\x35\xb4\xff\xec\x9a\xda\x3a\x97\xb3\xbc\xc2\xa3\xef\x95\x8a\x30\xbe\xfc\xa0\x71\x42\x72\x4a\xaa\x71\x2e\x3e\xf2\xe5\x30\x5e\x68\x36\xcd\x2a\x34\x17\x8e\x31\x2e\xc2\xdb\x42\xc6\x9a\x6a\xa3\xb9\xb2\xa6\xce\x98\xe3\x75\xed\xbc\xbb\xda\x08\xa1\xae\xf1\x42\x6f\xa5\xd3\x51\x6d\x9c\x56\x69\xaf\xc6\xfb\xf7\xa5\xc6\xa5\x78\x98\x8e\x94\xba\x5b\xdf\xc2\xfb\x8c\xd3\x6b\xa9\xbd\x83\x32\xe1\xc3\x66\x05\x9e\xba\xf6\xb3\x8d\x1e\x43\x0b\x09\x90\x7d\xcd\x18\x4f\xce\xe0\x82\x9e\xe2\x59\xd0\x41\xf0\xaf\x1b\xfc\x9b\xc4\xcf\x86\xa6\xc1\xbf\x18\xf1\x88\xa8\xe1\xdd\xf9\xf1\x06\xbb\x21\xd6\x60\x50\x0c\x86\x53\x4f\x89\x33\x0c\x53\x62\x0c\xc9\xdb\x14\xe5\x11\x60\x9a\x0f\x04\xf8\x52\xcb\x19\x57\x08\x62\x84\x4f\x07\xe6\x3c\xaf\xa2\xe0\xc3\xa6\xbd\x94\x14\xa5\x55\x3c\x6d\x1a\x7f\x9a\x41\x89\x1b\x14\xa3\x18\x16\x0c\x54\x94\x8a\x81\x8a\xe1\xf2\xbf\x29\xdd\xbe\x1a\xa2\xc4\xf9\x86\x2a\xf1\x83\x8d\x8a\x61\xf4\x30\x25\x7e\xf5\x30\xc5\xb0\x6e\xa2\xa2\x3c\x34\x51\x89\x29\x9a\xa4\xc4\x5f\x95\xa6\xc4\x76\x9f\xac\x28\x13\x26\x2b\x86\xdb\xa6\x40\x9d\x53\x94\x98\x3e\x19\x8a\xf2\x4d\xa6\x12\x33\xf5\x02\xc5\x90\x66\x52\x94\x65\x26\x25\xf6\x5e\x48\x9f\x82\xf4\x00\xa4\x47\x4c\x4a\xdc\xb4\x69\x8a\x32\x36\x47\x89\x2d\xcb\x51\xe2\x6f\xcd\x51\x94\x17\x73\x94\x98\xe3\x39\x4a\x9c\x79\xba\x62\x78\x78\xa6\x12\xfb\xc7\x4c\x25\xe6\x41\x9b\xa2\x7c\x68\x53\x62\xc6\xcd\x52\x0c\x8f\xcf\x52\x62\xfe\x80\x74\xe5\x6c\x45\x69\x98\xad\xc4\x9d\x96\xa7\x18\x66\xcc\x51\xe2\x7e\xc9\x57\x94\x2b\x0a\x94\xd8\xbb\x0b\x48\x0f\x73\xb0\x23\xfd\xf9\xd9\x59\xc3\x95\xf9\x8a\x61\x59\x5f\x43\xdf\x5e\xdd\x13\x36\xf2\x33\xbe\xa8\xc7\x11\xcb\x83\xf4\x5b\x26\x1f\xbf\x35\xf5\xe9\x7b\x75\x4c\x76\xef\x6e\xd9\x0d\x71\xd7\xc5\x0a\x3a\xfc\x80\x98\x87\xe9\x6e\x8f\x42\x37\x06\x1f\x0a\x86\x7f\xdf\x30\x5d\x0f\x83\x8e\x6e\x66\x43\xb7\xf5\xf1\xd7\xc5\x6d\x88\x15\xc5\x48\x7f\x07\xfc\x6b\x5e\x21\xe8\x83\x7a\xb9\x17\x36\x74\x5f\xdf\xad\x49\xe5\x40\xfa\x37\xe1\xdf\x86\x95\x82\x3c\xa5\xa7\x8e\xde\xd6\x9e\xfe\x04\xfc\xdb\x78\x8d\xa0\xff\xa9\x0b\xf2\xc7\x40\x9b\xe7\xac\x13\xf4\xef\x76\xeb\x5c\x3e\x3e\x94\x7f\xe4\x3a\x41\x6f\x8d\xd1\xd1\xe7\xb5\xa7\x5f\x0f\xf4\x7d\xae\x17\xf4\xb1\x7a\x7a\x9d\x7c\xd4\xfb\xf3\x40\xff\xc5\x26\x41\x7f\x8a\xbe\xfd\x4a\x48\x8f\x07\x81\x6e\x17\xd3\xc5\x74\xd2\x8e\x41\xaa\x3d\xe4\x59\xc9\x22\xba\xdf\x7e\x5f\x2f\x45\xe9\x2b\xed\x62\x12\xd7\xbd\xe4\x46\x21\xaf\x8e\xc7\x2b\xe1\x1f\xef\x8a\x82\x0e\xfa\x57\x09\x75\xff\xe7\x16\x41\x76\xbf\xbe\xbd\xba\xf1\xc7\x3a\xee\x00\xfa\xb1\x9b\x05\xfd\xc8\x0e\xec\x0f\x5f\x42\x38\xb7\x0b\x74\xdf\x01\xdd\x39\x5d\xa0\xeb\x8f\x69\x27\x74\xd8\x9f\x0c\x48\x87\x31\x5d\x2f\xbd\x3d\x47\x18\xaf\x4a\xc8\xfd\xc1\xf4\x63\x3a\xa8\xff\x46\x48\x0d\xb7\x76\x4c\x87\xe5\xcf\x43\x1a\xcf\x74\xc3\xe3\x3b\xb7\xaf\xef\x20\x97\x70\x97\xa0\x9f\xa3\xa7\x8f\x60\xbf\x83\xe2\x14\x65\xf4\xbd\x82\xfe\xe3\xb8\xce\xe5\x5b\x81\xe6\xfd\x07\x04\xfd\x83\x9d\xd8\x59\x67\x7e\x67\xd2\x43\xdc\x2f\x7d\xff\x95\x90\x7d\xa0\xdf\x19\xc7\x74\x0f\xeb\xe9\xa6\x37\x50\x5d\x9d\xd5\x73\x2b\xf3\x8f\xd6\xf7\x4f\xe7\xdf\x6e\xfb\x97\xa0\xf3\xe9\xeb\x99\xd6\x10\x1a\x5f\xf8\x77\x9c\xe9\x06\x76\xd0\xee\x1b\x71\x2c\x98\xae\x5f\x14\x3a\x1a\x5f\xf8\xf7\x21\xd3\x55\x74\xc1\x1f\x7c\x07\xff\xfa\x3c\xd6\xb1\x5c\xb2\x6f\xe8\xff\xb1\x6d\x82\x6e\x51\x14\xbd\x21\x1d\x7e\xac\x61\x42\x17\xe4\x95\x02\xdd\xa0\x2e\xd0\xa1\x7f\xeb\xd6\x05\xba\x27\x81\xee\x30\xb7\x6f\x54\x07\x74\x9f\x6a\xe8\x96\xeb\xe7\x9f\x86\x2e\x0e\x74\x97\xfd\xb8\xa0\x9b\x1f\xa5\xbf\xe4\xdf\x81\xce\xcd\x74\xf3\xf4\xf2\x22\xac\x07\x97\x02\xfd\x6d\x4f\x08\xfa\x7b\xba\x30\xff\xd7\x03\x7d\xaf\xa7\xba\xd0\x7f\xa0\x3b\xfa\xa4\xa0\xeb\xd9\x51\xff\x81\x6e\xdf\x93\x9d\xcb\x8b\x83\x16\xbc\xdc\x05\xba\x31\x40\xf7\x08\xd3\x9d\xa7\xa7\xd3\xd8\x39\xbe\xad\xf3\xef\x2e\xc8\x5b\x01\x74\xb7\x76\x41\xde\x3f\x21\x77\x47\x27\xf2\x68\x7d\x07\xba\xab\x99\x2e\x4f\x4f\x37\xa3\xfd\xfa\x71\x02\xf2\xf7\x33\xfd\xa1\x0e\xec\x63\x04\xcc\x7d\xe7\xd3\x82\x6e\x72\x07\xed\x44\xff\x76\x25\xd3\x4d\xd4\xd3\x69\xfc\x85\x07\xdf\x43\x66\xba\xd3\x3a\xd0\xcf\x1d\x40\x57\xdb\x05\xba\x5d\x40\x77\x09\xd3\x65\xe8\xe9\x34\xf6\xfb\x1d\xd0\xd5\x30\x5d\x7e\x27\xfa\x21\xff\x0e\x6b\xc0\xf5\x7f\x82\xde\x0a\xf4\x4f\xfc\x09\xfa\x15\x40\xbf\x87\xe9\x0b\xbb\x40\xff\x08\xd0\xff\xc6\xf4\x4e\x3d\x7d\x84\xf9\xf7\x29\xd0\x4f\xf8\x8f\xa0\xff\x9f\x9e\x3e\xc2\x7a\xd6\x0b\x94\xfa\x2b\xd3\x97\xc7\xea\xe8\x23\xac\x67\x19\x40\x3f\xee\x39\x41\x7f\x77\x27\xed\x21\xff\x0f\xf4\xff\x78\x2e\x8a\x7e\x34\x76\x74\x23\xd0\xbd\xc6\x74\x45\x51\xc6\x93\xe2\x3b\xa0\x3b\xda\x05\xba\x83\x40\x77\xd6\xf3\x9d\xd3\xf5\xea\x0e\x7b\x99\x2e\xd0\x4d\x02\xba\x95\xcf\x77\xec\x2f\x91\xee\x52\xa0\x7b\x94\xe9\x92\xf4\x74\x0c\x22\xdd\x5a\xa0\xbb\x8d\xe9\x06\x74\x40\xf7\x08\xd0\x5d\xdb\x49\xbd\x38\x2e\x1f\x00\xdd\xb3\x4c\xb7\xb6\x0b\xe3\x12\x97\x00\xeb\xe2\x0b\x82\x7e\x66\x07\x72\xc7\x00\x5d\x0a\xd3\xd5\x77\x62\x4f\xd4\x7f\xa0\x5f\xcd\xf4\xdd\xa2\xf4\x0b\xe5\xe2\xd7\x5a\x8a\x99\x6e\xa3\x9e\x2e\xc2\x3c\x78\x12\xe8\x5f\x64\xfa\x49\x9d\xac\x2b\x14\xdf\x03\xfd\xce\x1d\x82\xfe\xd1\x4e\xf6\x55\xb4\xfe\xf7\x50\x94\xac\x66\x41\xbf\xb4\x83\xf1\xc8\x00\xba\x0d\xcd\x1d\xf7\x8f\xd6\x7f\xa0\xab\x62\xba\x64\x3d\x1d\xdb\x3d\xed\x6f\x80\xce\xcb\x74\xaf\x75\x32\x6e\xff\xaf\xfc\x1e\x71\x89\x33\x88\xa7\x39\x6d\xe6\xf4\x75\x4e\x3f\xe0\x74\x3f\xa7\x01\x4e\x8f\x70\x7a\x82\xd3\x6e\x6e\x91\xf6\xe5\xf4\x4c\x4e\x47\x70\x3a\x8e\xd3\x34\x4e\xa7\x71\x6a\xe5\x74\x2e\xa7\x0b\x39\xbd\x9c\x53\x0f\xa7\x57\x71\xba\x8e\xd3\x4d\x9c\xde\xc1\xe9\xfd\x9c\x6e\xe3\xf4\x59\x4e\x77\x71\xfa\x36\xa7\xfb\x38\xfd\x92\xd3\xef\x38\x3d\xca\xa9\xe2\x11\x69\x4f\x4e\xfb\x73\x3a\x84\xd3\x51\x9c\x4e\xe0\x34\x83\xd3\xe9\x9c\xe6\x71\x7a\x31\xa7\xa5\x9c\x56\x73\xba\x8c\xd3\x35\x9c\xae\xe7\xf4\x66\x4e\xb7\x70\xfa\x10\xa7\x4f\x72\xba\x83\xd3\x16\x4e\xdf\xe3\xf4\x53\x4e\xbf\xe6\xf4\x30\xa7\xbf\x73\x8a\x7f\xff\x0c\xd3\x3e\x9c\x0e\xe0\x74\x38\xa7\x63\x38\xad\x7d\x42\xa4\x1b\x9f\x08\xbd\xaf\x8c\x3f\x39\x5f\x52\x33\xb8\x5f\x9c\xbe\xb2\x86\xe9\x62\xc2\xed\x49\xd2\xaf\x62\xba\x7f\x76\x42\x9f\xc6\xe9\x9e\x4c\x81\x37\x5c\xa0\xa3\xd7\xcd\xb6\x96\xee\x22\x9d\xb4\x44\xe0\x4f\x55\x22\xe3\xa7\x33\x7e\x48\x14\xbc\x83\xf1\x86\x28\xf8\x72\xc6\x77\x8b\x82\xaf\x66\x7c\xbf\x28\xf8\xd5\x4b\xda\xcb\xd6\xe2\xd7\x30\xbe\x7b\x14\xfc\xb5\x9d\xc8\xbf\x93\xf1\x67\x46\xc1\x7f\x56\x2f\xf0\xf1\x51\xf0\x5f\x31\xbe\x6f\x14\xfc\x2f\x8c\xaf\x8e\x82\x2f\x59\x2d\xf0\x09\x51\xf0\x95\xab\x3b\xd6\x9f\x9b\xf1\x71\x51\xf0\xcb\x56\x77\xdc\xfe\xab\x18\xdf\x33\x0a\xde\xc7\xf8\xde\x51\xf0\xb7\xac\xee\x58\xff\xff\xe8\xa4\xfd\xf7\x77\xd2\xbe\x47\x3a\xc1\x3f\xde\x89\xfc\x67\x18\x7f\x4a\x14\xfc\x2b\x9d\xc8\x7f\xa3\x13\xfd\xb6\x76\xc2\xff\x61\x27\xfa\xd9\xdf\x09\xfe\x6b\xc6\xf7\x8a\x82\xff\xb9\x13\xfe\xe3\xab\x3b\x9e\x9f\x7f\x74\xd2\xbf\x18\xf6\x1f\xfa\xc5\x5a\xe2\xbb\xad\xe9\xb8\xff\xbd\xd6\x74\x6c\xdf\x49\x8c\xef\x13\x05\x3f\x82\xf1\x67\x44\xc1\x67\xac\xe9\xd8\x3e\x2f\x5c\xd3\x71\xff\xad\x6b\x3a\xf6\x6f\x8b\x19\x3f\x28\x0a\x7e\xdd\x9a\x8e\xf5\xbf\xb1\x13\xfd\xdd\xd8\x09\xff\x1d\x8c\x8f\x89\x82\xff\x47\x27\xfa\xfb\xd7\x9a\x8e\xe7\xc7\x53\x8c\x4f\x8c\x82\x7f\x83\xf1\x49\x51\xf0\x5f\x74\xa2\xff\x1f\x19\xdf\x3f\x0a\x3e\xae\xa1\x63\xfb\xeb\xd1\xd0\x71\xfb\x06\x36\x74\xac\xbf\x61\x9d\xc8\xbf\xa8\xa1\xe3\xfe\xd5\x34\x74\xec\x3f\x96\x37\x74\x3c\x3f\xd7\x75\xd2\xbe\x8d\x0d\xe1\xf3\x67\xe3\x9b\x02\x3e\xc0\x69\xfd\x73\x22\x6d\xe5\x34\xe1\x2d\x8e\xef\x38\xdd\xce\x69\xdf\xb7\x45\x5a\xf1\x76\xb8\xad\xe3\x3e\x0c\xe1\xfc\x6b\xd8\xcf\xdf\x22\x52\xbc\x0f\x86\xa9\xf5\xe6\x70\xfa\x85\xcf\x45\x5e\x6f\xf5\xf2\x1e\x60\x79\xfd\x58\xde\x88\xc1\x22\xb5\xeb\xe4\x6d\xeb\xa2\xbc\xf3\xae\x13\xe9\x60\x96\xf7\x22\xcb\x43\x3c\x9e\x99\x8c\xbf\x41\xc0\x4f\xdc\x12\x2e\xcf\xcd\xed\xb0\xdd\x16\x5e\x9e\x76\x43\x78\x7f\xe5\x2f\x93\xcb\x8b\x74\xf4\xb3\xb8\x7c\xa1\xae\xfc\x72\x2e\xaf\xd6\x95\x5f\xcf\xe5\xab\x74\xe5\x75\xdc\x9e\x6b\x74\xe5\xb7\xde\x10\xb9\xfc\xa1\x28\xfd\xda\xc1\xe5\x0f\xe8\xe8\xdf\x8b\xd2\xaf\x8f\xb8\xfc\x71\x1d\xfd\x17\x51\xe8\xbf\xe7\xf2\xe7\x74\xf4\xbf\x71\xf9\x4e\x5d\x79\x85\x2f\x32\xfd\xc9\x1b\x22\xeb\xbf\xff\x75\x91\xeb\x95\xe3\xdd\xe3\xc6\xc8\x78\x8c\xa3\x70\xae\xde\xcc\xf3\xe2\x76\x4e\xb7\x70\xfa\x30\xa7\xdb\x38\x7d\x9e\xd3\xb7\x74\xb0\xd4\xa7\x6c\x97\xac\x47\x8e\xbb\x1c\x67\x39\xae\x72\x1c\xaf\xd1\xa5\x9b\x6e\x0b\x1f\x07\x29\xe7\x71\x1d\x2c\xf5\x22\xf5\x26\x61\x59\xff\x1e\x5d\x2a\xfb\x2c\xe7\xb3\x56\x3f\x78\x2f\x6a\x1a\xeb\x6f\xa4\x4e\x3f\x97\x71\xf9\x85\xba\x72\x0f\x97\xaf\xd2\x95\xfb\xa3\xd0\xdf\x77\x5d\x64\xbb\x6b\x89\x52\xfe\xdf\x28\x72\xe2\x36\x44\x2e\xff\x1b\x97\x1f\xd6\x95\xe7\x6d\x88\x2c\x7f\x35\x97\x5f\xb0\x39\xbc\xfc\xf1\x0d\xe1\x7e\x46\xfe\x76\x73\xb9\x5f\x47\xff\xdf\x0d\x91\xf5\x70\xd6\xc6\xc8\xe5\xf6\x28\xe5\xfe\x28\xe5\x8f\x47\x29\xdf\xb7\x31\xb2\x1e\xba\x5d\x2f\xe0\xd2\x5b\xc3\xcb\xa7\x70\xf9\xd3\xba\x72\xcf\xf5\x91\xe5\xdf\xc2\xe5\xe7\xe8\xe6\xd9\x7f\xa2\x94\x7f\x18\xa5\xfc\x50\x94\xf2\x60\x94\xf2\xd3\x37\x45\x2e\x1f\x13\xa5\x3c\x37\x4a\xb9\x23\x4a\xf9\x9a\x28\xe5\x77\x45\x29\x7f\x2a\x4a\xf9\x1b\x51\xca\xff\x1b\xa5\xfc\x94\x1b\x22\x97\x27\xb3\xde\xe7\x73\xda\xa4\x83\x77\xe8\xd2\x7d\x3a\x7c\xdc\xe6\x70\xbc\x95\x61\x27\xa7\xb7\x72\xda\xc2\xe9\xf7\x9c\x9e\xc6\x76\x70\x81\x2e\x95\x72\x97\x30\xbc\x9b\xd3\xdf\x39\x9d\x70\xdb\xff\x99\xb4\x23\xff\x85\xb1\xee\x99\x37\x46\xb6\xe3\xdc\x28\xe5\x75\x37\x46\x9e\x3f\xdf\x70\x7d\x32\x3d\xeb\x76\x91\xca\x78\x58\x1f\x07\xc9\x1f\x98\xb3\x32\x8a\xff\x69\x7f\x4b\x64\x7c\xf2\xbc\x48\xd3\x76\x85\xf3\xe9\x7f\xd8\x1f\x8c\xed\xe6\xaf\x13\x74\x32\x3e\x69\x19\x22\x52\xfd\xfc\xdb\x3d\x54\xa4\x99\xd7\x86\xfb\xad\x63\xa3\xb9\xdf\xbc\x8e\x16\x73\x3f\xda\xce\x15\xe9\xa9\xbe\x70\x39\xcf\xa6\x88\xf4\xe1\x1b\xc3\xfd\x65\xb7\x49\x22\xd5\xeb\xab\xc7\xf9\x22\xd5\xeb\xbd\x3f\x97\xeb\xf5\x3e\x96\xcf\x87\x76\xad\x0f\x97\xb3\x30\x5b\xa4\xbf\xac\x0b\x6f\xcf\x63\x16\x91\x36\xdf\x18\xae\x07\xbf\x4d\xa4\xff\x7f\xad\xdb\x78\x6e\x9e\xa0\xb4\xdf\x2f\xca\x71\xdb\xb2\xab\xe3\x71\x6b\x79\x85\xe7\x53\x94\xf1\x7f\x99\xe5\x04\x5e\xed\x78\xdc\xe5\x4f\x7f\x6e\xa4\x97\xd3\xd5\x9f\x8c\x7b\x0e\xb1\xfe\x96\xf0\xf8\x1b\xe6\x88\x14\xf1\x68\xd3\x67\x34\x09\xf8\xca\xdb\xc3\xe5\x1f\x65\xbe\x46\x5d\xb9\xa4\x93\xe5\x52\x8e\x3e\x3e\x97\xbf\x29\xbe\xc8\xf3\x4f\xd2\xc9\xf2\x19\xcc\x2f\xf7\x0d\x0e\x4e\x65\xbc\x2d\xe3\xdc\x75\x9c\xde\xc0\x69\x33\xa7\x7b\x38\xfd\x9d\xd3\x5e\x6c\x4f\xa3\x38\x9d\xc8\xe9\x54\x4e\x73\x39\x95\xf3\xac\x82\xd3\x65\x9c\xae\xe7\x54\xf6\xeb\x56\x86\x9f\xe2\xf4\x35\x4e\xf7\x72\xfa\xd9\xba\x70\x3b\x96\xf3\x2b\x99\x53\xa9\x87\xf9\x9c\xca\xf8\xb6\xca\x17\x3e\x4f\x9f\xe7\xf4\x0d\x4e\xdf\xe3\xf4\x23\x4e\xbf\xe5\xd4\xc0\xf3\x3d\x9e\xd3\x44\x4e\x07\x71\x9a\x7c\x6d\xb8\x5f\x98\xc5\x69\x31\xa7\xd5\x9c\xae\xe2\x74\x03\xa7\x8f\x70\xfa\x38\xa7\x2d\x9c\x7e\xca\xe9\x8f\x9c\x76\xf7\x73\x7d\x9c\x8e\xe5\x74\x0a\xa7\x17\x71\x5a\xc6\xe9\x72\x4e\x37\x71\xfa\x02\xa7\xaf\x72\xfa\x15\xa7\x01\x4e\xff\xe0\xb4\x17\xfb\x8b\x11\x9c\x4e\xe0\xd4\xcc\xe9\x3c\x4e\x2f\xe3\xb4\x84\xd3\x2b\x38\x5d\xc9\xa9\x9f\xd3\x3b\x39\x7d\x62\x7d\xb8\x3f\xda\xcb\xe9\x41\x4e\x8f\x70\x6a\xe0\xf9\x21\xe7\x49\x0a\xa7\xd9\x9c\x5a\x39\x2d\xe0\x74\x3e\xa7\xe5\x9c\xae\xe6\xf4\x46\x4e\xff\xc9\xe9\x7f\x38\xfd\x88\xd3\x1f\x39\xfd\x1f\xa7\xc7\x39\x8d\xe3\x38\x58\xee\x6f\x8c\x9c\xee\xe2\xf9\x29\xfd\xe3\x1e\x4e\xbf\xe1\xf4\x30\xa7\x72\x1e\xf7\xbf\x49\xa4\x67\x71\x9a\xc3\xe9\x3c\x4e\x9d\x9c\xca\xfd\x9e\xdc\xdf\xc9\xfd\xdc\x4a\xc6\x5f\xc7\xe9\xc3\x9c\xbe\xc4\xa9\xdc\xff\xbe\xcd\xf0\x47\x9c\xfe\x8f\xd3\xfe\xec\xbf\x26\x72\x6a\xe2\x34\xda\x39\xc2\xff\xad\x3f\x79\x9e\xd2\xd9\x6f\x3e\xaf\x27\x15\x9d\xd0\xf7\xe5\x75\x63\xbb\xee\x9c\xa6\x9d\x3c\x1d\x5d\xb4\xdf\x16\xdd\x3a\xb4\x59\xb7\xae\x18\x9f\x0f\x87\x0f\xbf\x10\x0e\xf7\xdd\xc1\x76\x2e\xeb\xdd\x11\xb9\xbe\x35\x2c\xf7\x58\x14\x7c\xdf\x17\xa3\xf4\x83\xd3\x8d\x2f\x47\xc6\xdf\xcd\x72\xb7\xef\x8c\x8c\x6f\x8d\x52\x2e\xe5\x1a\xa3\xac\xe3\x6f\xeb\xf4\x90\xb0\xbb\x8b\xe3\xc8\x69\x56\x14\xfa\xef\x75\x72\xf3\xfe\xa4\xdc\x75\x3a\xfa\x07\x1e\x7e\x68\x42\x17\xd8\xff\xfa\x45\xf9\x9d\x3a\xbc\xc5\xb0\x30\x7b\x5a\x41\x36\xfe\x57\x9f\x31\xa0\x36\xe1\xa2\x69\x85\xa6\x5a\x23\xfe\x2b\x30\xe5\x77\xe5\xbf\x69\xf9\xd3\x16\x0e\x32\xd5\xf6\xed\x12\x71\x97\xfe\x5b\x38\x52\x15\x07\x6d\xc8\x29\xc8\x59\x38\xc2\x34\x3f\xbb\x60\x5a\xed\x80\xfc\x19\xb5\xc9\xda\x66\x2d\x6c\x30\x24\x37\x1b\x6a\x8d\xf9\x80\xcd\xcf\xae\x8f\x4f\x00\x3e\x20\x93\xd7\xda\x84\xda\x51\xe2\x3a\x47\xf6\x37\xab\xb8\xfd\x8b\x10\xfc\x2d\x0b\xf5\xdb\x2e\xca\x1c\xd7\x80\x8d\x59\xc5\xb3\xa7\x5d\x98\x5d\x9c\x6d\x35\x15\x14\x14\x8f\x28\x56\x3f\xa5\x56\x1f\x38\x7d\xcb\x46\x63\x7b\x74\xe8\x73\x1f\xf8\xf9\x97\xfa\x8d\x86\xf6\x24\xd3\xd5\x8f\xb0\x28\xf5\xfc\x7c\x72\x30\x18\x81\x0e\xbf\xea\x51\xe0\x70\xd1\x9b\xbc\xe2\xfd\xe5\xfa\x8a\xf6\x54\xb3\xe8\xdb\x39\x4a\xfd\xb6\x0e\x24\xe5\xe1\xb7\x5f\xea\x13\x22\x20\x34\x1f\x71\xaa\xdf\xda\x81\x04\xfe\xe8\x53\xfd\x81\x0e\x68\x0a\xed\xee\xc5\x11\x9b\x58\x58\x81\x9f\x6a\x52\xea\xfb\xb6\x47\xe9\xc7\x40\xa9\x6f\xee\xa0\x86\x28\x1f\xd9\x81\x9e\xf5\x6b\x47\xcb\x2f\x0e\xd7\xaf\x53\xe5\xd8\xcc\x85\xa6\xf6\xf8\x96\x50\x7d\x40\x58\x2c\xbe\x14\x56\xeb\x70\xb9\xf1\xbb\xbd\x4e\x4f\x5d\xf1\x92\x64\xa5\x7e\xfb\x6e\x4d\xa3\x04\x89\xa3\xaa\x16\x90\x25\xf8\xfd\x51\x68\x74\x5a\x73\xac\x51\x87\x5a\x22\xbe\x5e\x5e\xbf\x4e\xad\x40\xd0\x84\x7f\xab\xac\xbe\xe5\x55\x8d\xe8\x28\x1f\x55\x83\x0e\x9a\xa2\x7f\xa9\x4f\xf2\x45\xf9\xbc\x99\x44\xab\xdf\x5e\xd3\x14\x84\xbe\xbe\xa6\x29\x54\x3f\xab\xa6\x29\x0b\xff\x26\x9b\x44\x68\xbf\xca\x26\xcb\xaa\xdc\xe5\x05\x8e\xea\x52\x45\xb6\x38\xfc\xb3\x71\x92\x4a\x7e\x8b\x20\x04\xd3\xe7\x0a\x04\x18\xe9\xfb\x71\x80\x69\xf7\x55\xb7\x8d\xa7\x81\xc6\x79\xd6\xe6\x7b\xab\x1d\x56\x50\x1b\x6a\x1c\xff\x1a\x42\x68\xb4\xe8\x53\x78\x25\x50\x01\x7e\x14\xa4\x64\xb1\xf8\x02\x20\x0d\xb5\x1b\xe1\xe2\x92\x8a\xc5\xc5\xe5\x5e\xbb\xab\x54\xcc\x90\x62\xfc\xb8\x2c\xbe\x7b\x5f\x8c\x5f\x73\x2b\xbe\x02\x48\x4b\xeb\x2a\x4b\x81\xd8\xbb\xa8\x78\x91\x93\x3e\x60\x5f\xbf\x3d\x64\x34\xfd\xd1\x95\x98\x6a\x6b\x67\x3a\xe5\x9b\x76\x72\x46\x46\x98\x6f\xda\x8f\x11\x80\xd1\xb4\x27\x10\x43\x56\xbf\xb1\x83\x49\x10\xfa\x7a\x5a\x7d\x6b\x88\x2c\xa9\x7d\x5d\xf4\x91\x9f\xfa\xbc\x88\x3e\xca\xde\xf1\x74\xa7\x0f\x10\x45\x9a\xca\x9a\x8f\x33\xd6\x1f\x3e\x19\x5d\x40\xd8\x87\x20\x23\x7a\x41\xf9\xb5\x47\x6a\x4b\x6b\x07\x6d\x69\xff\x59\xc8\x0e\x7d\x5d\x51\xbe\x55\x89\xec\x39\xd4\x6f\x15\xd5\x07\x3e\x97\xfc\x5b\x5a\x87\x92\x29\x64\x4f\x0f\xff\x5e\x22\x59\x6c\xbe\xfc\xc4\x34\x0c\xf8\x3b\xea\x24\xce\xc0\x45\x61\x31\x32\xb8\xc0\xd3\xc3\x6a\x20\x3e\x24\x1a\x3e\xf6\xfb\xbe\x55\x5b\x88\xeb\x8d\xab\xc5\x40\x4b\x8e\xb5\xa6\x5c\xd9\xa8\xb8\x5a\x0d\xa1\x05\x88\x0c\x59\x71\xed\x33\xf4\x93\xae\xc1\x5e\x5a\x0a\xf3\xb2\xa2\xa6\x14\xcb\x0f\x18\xfa\x99\x42\xe5\xf2\xed\x5c\xc4\x04\xc2\x38\x42\x93\x56\x71\x1d\x0e\x61\xca\x43\x5f\xce\x0c\xc9\x3c\x16\x11\x5f\x80\xdf\x8b\x00\x6c\x7d\x4c\x24\xac\xfa\xdd\x4d\xa0\x58\x17\x4e\x01\xf3\xd3\x6a\xaf\xc3\x6f\xc9\x02\x6e\x63\x18\xae\x20\xf4\x65\x11\xc0\x6d\x0e\xe1\xf0\xbb\x26\xaa\xeb\x51\x5c\x5b\x42\x18\xfe\xce\x46\xa8\xb5\x5b\xb5\x38\xfd\x87\x4e\x90\x60\x1b\x12\x38\xa1\x15\x21\xc7\xa0\xb8\xb6\x6b\x0b\x67\x97\x95\xb9\x1d\xd4\xbc\x66\x2c\xae\x22\xd9\xc5\x8e\x65\x25\xf4\x75\x97\x5c\xfc\x7c\x31\xbe\x36\xca\x9f\x5e\x03\xb2\x16\x0d\x19\x7e\xff\x2e\x8c\x82\x46\x10\x09\xa2\x7d\x21\x15\x87\x33\x2a\x9e\x3c\x36\x8c\x6b\x24\x02\xed\x77\x40\x71\x84\x55\x1a\x18\x58\xec\x2f\x7d\xbe\x03\x47\x81\x14\x73\x58\x83\xae\x16\xdf\x7f\x96\xa3\x45\xa3\xac\xa2\x1d\xf2\x23\x72\x35\xd5\x38\x99\x64\x1f\xea\x63\xfb\xb5\x5b\x1a\x60\x70\xb5\xa5\x61\x83\xb4\x51\x8b\xd1\xda\xdb\x66\x2d\x42\xb7\x52\xc0\xd8\xaa\xd8\xd0\x77\x49\x34\x0b\x02\x0c\xb0\x4a\x10\xb6\x9e\xc0\xc0\xaa\x08\xf9\x95\xca\x30\xbe\xed\x1a\xb4\xfe\x4b\xad\x38\xd4\xd1\xd1\xa5\x3a\x45\xb7\x30\x29\x2f\x3c\x6a\x03\x5a\xc3\xcb\xe9\x3b\xb3\x38\xb8\x9a\x62\x77\xa8\xf8\x40\x2c\x4c\xd5\x5a\x9e\xa1\xb4\x1a\x9b\x3c\xe0\x4c\x16\x79\x3d\x0e\x7c\x9b\x9e\x46\x14\x39\xb5\xeb\x98\x30\x73\xed\x07\x77\x70\x60\x91\xca\xed\x20\x82\x22\xa7\x98\xb2\xb1\xb8\xd6\xd1\xda\x25\xbe\x70\x8b\xe3\x17\x17\x2a\xe3\xd6\xe0\xda\x55\x23\xba\xbf\x8e\xb0\x45\xd5\x4b\x61\xc9\x2a\xce\x77\xb8\xbd\x62\x5e\x6c\xa4\x62\xcd\xd2\x57\x66\x77\x8a\x41\x24\x04\x2d\x75\x52\xdb\xc5\x65\xde\xea\x92\xe2\xb2\x1a\x17\x3a\x98\x62\xa7\x88\x26\x60\x40\x91\xd2\xee\xa6\x8f\xa1\x95\xd1\x00\x62\xc1\xa2\x2b\x1d\xae\x1a\x1a\x35\x84\x4a\xc4\xa7\x37\x71\x94\x10\x54\xd7\x54\xbb\xbb\xae\x9a\x8a\x9b\xc3\x8a\xa1\xa3\xc5\xe5\x95\x35\x8b\xec\x95\xc5\x57\x78\x1d\x3c\x28\x48\xe1\xc0\x4f\x02\xe2\x48\x20\x50\x06\xf6\x21\x06\x80\x20\x97\x83\xe8\x0e\x20\x80\x1f\xd6\x2c\x86\x45\x1e\xd7\x2d\xd4\xb3\x5a\xe6\x76\xd8\x5d\x25\x34\xe3\x0e\x63\x99\xd3\x0d\x81\x39\x69\xef\x18\x82\x55\x6a\x33\xeb\xe3\x11\x74\x54\x95\x54\xd1\xfc\x5d\x27\x41\xa1\xea\x8d\x08\x86\x3a\xbc\x99\x40\x0f\x85\xb5\xc5\x55\x30\xbe\xcb\x8a\x45\x54\x01\xca\x69\x8f\xf2\x56\x4b\xe4\x56\x44\xe2\x97\xad\xec\xa4\xf2\x6d\x04\x7a\x5c\x5c\xe7\x76\x06\x2b\x1d\x64\x05\xcd\x0c\x56\x33\xba\x25\x1e\xd6\xf8\xec\xe9\x62\x7d\x9a\xa1\x7e\xbb\x27\xcf\xe3\x52\xd4\xc7\xdc\x15\x43\x71\x71\x55\x45\x31\x7f\x23\xa9\xb8\x02\x1a\x01\xab\xd1\x19\xea\x63\x4b\x8f\x4c\x5e\x76\xbb\xc1\x17\x13\x34\x3c\xd6\xff\x27\xc3\xbb\xbd\xdf\x8c\x6b\x88\x3b\x65\x4d\xdc\x6f\xdd\xee\x37\x8c\x84\xdf\xfa\x98\x31\x63\x9e\xe8\xf9\x6e\xcf\x8f\x7b\x3c\x18\x3f\xfc\x61\xc3\x83\x09\x3e\xc3\xc0\x7e\x81\xb8\x7e\x8b\xfa\xf5\xeb\x37\xea\x78\xcc\xfc\xbd\x31\xf7\xc4\xf6\xeb\xd9\xaf\xdf\x79\xf0\x5f\xde\xa1\xd8\xc9\x13\x4f\x3b\x2d\x23\x3f\x3f\xbf\xd0\xf9\x3f\xc3\x37\xdd\xee\x36\xe4\x17\xc1\x6f\x7e\xd2\x80\xf9\x6b\x0d\x33\xeb\x0d\xdd\x36\x1a\x9e\x8c\x79\x34\x76\x69\x37\x7c\x86\x16\x1b\x50\xaa\xd9\x6f\x8e\xd4\xc1\xf8\x7e\x4f\x59\xac\x41\x49\xaf\xae\x9b\x4b\xdd\x80\x7f\xe3\x0c\xa1\xe7\x63\x5e\x80\x7f\x23\x34\x30\x9d\x7d\xc2\x3f\xf9\x9e\x34\x9d\xe5\x1a\xc2\x61\xe4\x97\xcf\xc3\x1c\x61\x7e\x09\x4b\xfe\xdb\x75\xfc\xb7\xeb\xf8\xe5\xf3\x2f\x13\x0d\x82\xff\x45\x1d\xbf\x7c\xaf\x5a\xf2\x6b\x61\xe4\x97\xf7\xa7\x2e\x62\x7e\x09\x4b\xfe\xa0\xae\xfe\xa0\xae\x7e\x79\x1f\x6b\x19\xf3\x4b\x58\xf2\xcb\xf7\xae\x25\xbf\x16\x46\x7e\x79\xbf\xeb\x7e\xe6\x97\xb0\xe4\xff\x49\x57\xff\x4f\xba\xfa\xe5\x7d\xb1\x57\x99\x5f\xc2\x92\x5f\xbe\x97\x2d\xf9\xb5\x30\xf2\xcb\xfb\x67\xfd\x62\x04\xbf\x84\x25\xbf\x7c\x4f\x5b\xf2\x6b\x61\xe4\x97\xf7\xd9\xb2\x98\x5f\xc2\x92\x3f\x56\xc7\x1f\xab\xe3\x97\xf7\xe3\x4a\x99\x5f\xc2\x92\xff\x14\x5d\xff\x4f\xd1\xf5\x5f\xde\xb7\xbb\x8f\xf9\xfb\xeb\xf8\x63\x74\xf5\xc7\xe8\xea\xc7\xef\x19\x60\xfe\x2b\xe6\x97\xb0\xe4\x97\xef\x73\x4b\x7e\x2d\x8c\xfc\x1b\xa6\xb0\xfd\xc5\x0a\x7e\x09\x4b\xfe\xfb\x75\xed\xbf\x5f\xd7\x7e\xf9\x9c\x7b\x23\xf3\x4b\x58\xf2\x8f\xd4\xf1\x8f\xd4\xf1\xcb\xe7\xe3\x6f\x67\xfe\x8b\xff\x24\xbf\x7c\x6e\xfe\x49\xe6\x5f\xf5\x27\xf9\xe5\xf3\xf6\x6f\x31\xff\x3f\xff\x24\xff\x6b\x4c\xdf\xc6\xfc\xaf\xe9\xf8\x7b\xe9\xe6\x6f\x2f\xdd\xfc\x95\xcf\xf1\x67\xc4\x09\x7e\xf5\xb9\x7e\xe6\x1f\xa3\xab\x7f\x8c\xae\x7e\xf9\xfc\x7f\x11\xf3\x4b\xb8\xab\xfc\xf2\xfe\xf0\x15\xcc\x3f\x56\xc7\x3f\x31\x3e\x9c\x5f\x0b\x23\xbf\xbc\x8f\xbc\x8d\xf9\x25\x2c\xf9\xe7\xe8\xf8\xe7\xe8\xf8\xe5\xfd\xe6\xf7\x98\x5f\xc2\x92\x5f\xbe\xa7\x2e\xf9\xb5\x30\xf2\xcb\xfb\xd2\x27\x99\x5f\xc2\x92\xff\x41\xdd\xfc\x91\x30\xfe\x31\xc0\xb3\xe3\xc5\xb7\x40\x88\x16\xf2\x46\x25\xf4\xcb\xd4\xc1\xf8\x47\x01\xcf\xee\xab\x28\x07\x1e\x17\xf4\x6e\x86\xfb\xf2\xfb\x1f\xd7\x31\x9c\xc5\xf0\x83\x3a\xfe\xdd\x3a\xf8\x0b\x1d\x7c\x4c\x07\x93\x4f\x31\x84\x60\xfd\xfa\x86\x2f\x6c\x69\xe1\xfe\xdd\xc4\xfa\xf6\x78\x9f\xa5\x61\xeb\xdb\xa4\x3c\x91\xbf\xa5\x9b\xd0\x8f\x84\xa5\x7e\x86\x2b\xe1\xfa\xd1\xc2\xc8\x3f\x97\xe9\xb7\x32\xff\x5c\x1d\xff\xc3\x3a\x7e\x09\xff\xab\x5b\xc7\xfd\x79\x4e\xd7\xfe\x13\x3a\xb8\x7b\x77\xd1\x9f\x69\x07\xb2\xc3\xfa\x23\xef\xa3\xaf\xee\xce\xf6\x3e\x27\xbc\x3d\xa3\x75\xf6\x32\x5a\x67\x2f\xe7\x16\x89\xfc\x66\xe6\x97\xb0\xe4\xf7\xe9\xfa\xe3\xd3\xe9\xe3\x09\xa6\x7f\x8b\xf9\x9f\xd0\xf1\x0f\xd4\xf1\x0f\xd4\xf1\xbf\xc9\xf4\xbf\x32\xff\x9b\x3a\xfe\x7e\x3a\xfe\x7e\x3a\xfe\xfd\x4c\x3f\x2c\x41\xf0\xef\xd7\xf1\x57\xe8\xec\xbd\x42\xb7\x5e\x4c\x9f\x2f\xf2\x85\xcc\x2f\xe1\xae\xd6\x3f\x9f\xe9\xff\xc1\xfc\xf3\x75\xfc\x8b\x74\xfc\x8b\x74\xfc\xcf\x32\xfd\x29\x3d\x78\xbd\xfe\x93\xf5\xbf\xc9\xf4\x55\xcc\xff\xe6\x9f\xe4\xdf\xcf\xf4\x3b\x99\x7f\xff\x9f\xe4\x3f\xcc\xf4\x93\x7b\x0a\xfe\xc3\x3a\xfe\x51\x3a\xfe\x51\x3a\xfe\x01\x17\x89\xfc\x63\xcc\x2f\x61\xc9\x2f\xbf\x7b\x20\xf9\xb5\x30\xf2\xdf\x70\xb1\xc8\x7f\xcc\xfc\x12\x96\xfc\xf3\x75\xf5\xcf\xd7\xd5\x7f\x88\xe9\x07\x9c\x22\xf8\x0f\xe9\xf8\xe7\xe9\xea\x9f\xa7\xab\x7f\xda\xa5\x22\x5f\xc0\xfc\x12\x96\xfc\xf7\xe8\xf8\xef\xd1\xf1\x1f\x5c\x20\xf2\x57\x31\xbf\x84\xbb\xaa\xff\xa3\x4c\xbf\x95\xf9\x8f\xea\xf8\x7b\xea\xf8\x7b\xea\xf8\x95\xcb\x44\x7e\x2f\xf3\x4b\xb8\xab\xf5\xf7\x63\xfa\x98\x5e\x82\xbf\xdf\x9f\xe4\x1f\xc1\xf4\x29\xcc\x3f\x42\xc7\x7f\x9e\x8e\xff\x3c\x1d\xff\x6c\xa6\x2f\x63\xfe\xd9\x7f\xb2\x7e\x3b\xd3\x6f\x61\x7e\xfb\x9f\xac\x7f\x3d\xd3\x1f\x60\xfe\xf5\x7f\xb2\xfe\xbb\x98\x7e\x58\x6f\xc1\x7f\x97\x8e\x3f\x4f\xc7\x9f\xa7\xe3\xff\x81\xe9\x0b\x99\xff\x07\x1d\xff\x21\x9d\xfd\x1d\xd2\xd9\xdf\x47\x0b\x79\xfd\x60\x7e\x09\x4b\xfe\xc9\xba\xfa\x27\xeb\xc7\xdf\x2e\xf2\x9f\x31\xbf\x84\x25\xff\x44\x1d\xff\x44\x1d\xbf\x99\xe9\xcf\xed\x23\xf8\xcd\x3a\xfe\xd3\x74\xfc\xa7\xe9\xf8\xe7\x33\x7d\x15\xf3\xcf\xff\x93\xfc\x8b\x99\x7e\x07\xf3\x2f\xd6\xf1\x67\xe8\xf8\x33\x74\xfc\x0f\x30\x7d\x62\xa2\xe0\x7f\x40\xc7\x9f\xaf\xe3\xcf\xd7\xf1\xff\xc6\xf4\x97\x32\xff\x6f\x7f\x92\x7f\xe6\x22\xb6\x5f\xe6\x97\x70\x57\xf9\xef\x64\xfa\xff\x32\xff\x9d\x3a\xfe\x42\x1d\x7f\xa1\x8e\xff\x27\xa6\x4f\xee\x2b\xf8\x7f\xd2\xf1\x3b\x75\xfc\x4e\x1d\xff\xc5\x25\x22\xbf\x82\xf9\x25\x2c\xf9\xff\xa7\xe3\xff\x9f\x8e\x7f\x76\xa9\xc8\x7f\xc4\xfc\x12\x96\xfc\xe5\xba\xfd\x5e\xb9\x6e\xbf\xf7\x74\xb9\xc8\x0f\x3a\x55\xf0\x4b\x58\xf2\xdf\xad\xab\xff\x6e\x5d\xfd\xf3\x2a\x78\xfe\x31\xbf\x84\xbb\xaa\xff\x07\x99\xde\xc7\xfc\x0f\xea\xf8\x8b\x74\xfc\x45\x3a\xfe\x93\x4c\xff\x10\xf3\x9f\xfc\x93\xfc\x05\x4e\x91\x7f\x87\xf9\x25\xdc\x55\xfe\xad\x4c\x7f\x8c\xf9\xb7\xfe\x49\xfe\x3f\x98\x7e\x50\x3f\xc1\xff\x87\x8e\xbf\xb3\xf5\xbb\xe8\x72\x91\xcf\x62\x7e\x09\x4b\xfe\x24\x1d\x7f\x92\x8e\xff\x72\xa6\x5f\xcc\xfc\x97\xeb\xf8\x07\xe8\xf8\x07\xe8\xf8\xd7\x30\xfd\xcd\xcc\xbf\xe6\xf2\x3f\xd7\xfe\x8f\x99\x7e\x37\xf3\x7f\xac\xe3\x5f\xab\xe3\x5f\xab\xe3\xbf\x6c\xb1\xc8\x9f\x64\x7e\x09\x4b\xfe\x99\x3a\xfe\x99\x3a\xfe\x07\x99\xfe\xef\xa7\xb1\xfd\xe9\xf8\xeb\x75\xfc\xf5\x3a\xfe\xd1\x95\x22\x7f\x05\xf3\x4b\x58\xf2\x77\xd3\xf1\x77\xd3\xf1\x8f\x67\xfa\x7f\x32\xff\x78\x1d\xff\x46\x1d\xff\x46\x1d\xff\x8b\x4c\xff\x3e\xf3\xbf\xa8\xe3\x97\xdf\x17\x91\xfc\x5a\x18\xf9\x7f\xae\x12\xf9\x5f\x98\x5f\xc2\x92\xff\x51\x1d\xff\xa3\x3a\xfe\x2d\x35\x22\xdf\xab\xbf\xe0\x97\xf0\xf8\xfe\xa1\xb1\x52\x34\xf2\x96\xea\xfa\xb3\x54\xd7\x9f\x33\x6a\x45\x7e\x1b\xcb\x93\x70\x57\xf5\x39\x98\xe9\x3f\x64\xfe\xc1\x3a\xfe\x64\x1d\x7f\xb2\x8e\xdf\xc6\xf4\xbf\x32\xbf\x4d\xc7\xff\x9a\x8e\x5f\xc2\xc6\xa4\xf0\xfd\xed\x44\x80\xcf\x3e\x23\xf4\x3c\xe0\x45\x0c\xcb\xe7\xfe\x96\x33\x2c\x9f\x47\xbf\x47\xc7\xdf\x8c\xf8\xbf\x85\x9e\x33\xfc\x1c\xe1\x41\x50\xce\xcf\x67\x1e\x41\x78\x08\xf0\xbc\x23\xe0\xbe\xa7\x0b\x38\x99\xe1\xd9\x0c\xe7\x31\xbc\x91\xe1\x85\x0c\xef\x64\xd8\xd8\xca\xfe\x8b\xe1\x51\x0c\x0f\x3e\x83\xe5\x31\x6c\x62\x38\x8d\xe1\x5a\x86\xb3\x18\xbe\x8d\x61\x0b\xc3\xcf\x32\x9c\xc7\xf0\x5e\x86\xe7\x33\xfc\x1d\xc3\x0b\x19\x3e\x63\x80\x80\x2b\x18\x9e\xcc\x70\x2d\xc3\x97\x32\xbc\x8c\xe1\x46\x86\xeb\x19\x7e\x84\xe1\x75\x0c\x7f\xc2\xf0\x46\x86\xfb\x0d\xe4\xf6\xbe\x2b\xe0\x99\x0c\x5b\x18\xbe\x82\xe1\x3c\x86\x6f\x61\x78\x3e\xc3\x4f\x32\xbc\x90\xe1\x3d\x0c\x57\x30\xfc\x3b\xc3\xb5\x0c\x9f\x79\x26\xb7\x97\xe1\x74\x86\xeb\x19\x9e\xc7\xf0\x3a\x86\x97\x32\xbc\x91\xe1\x7b\x18\xde\xfc\x6e\xc8\xfe\xb4\xe7\x25\x8f\x01\xbe\x8f\x21\x74\x7f\xe2\x6b\x86\xe5\xfd\x86\x73\xfe\x26\x60\x79\xff\xc0\xc6\xb0\xbc\x1f\x50\xc9\xb0\x3c\xdf\xbf\x8d\x61\x79\x5e\xff\x2c\xc3\xf2\xfc\xbd\xdb\x20\x01\xcb\xf3\xf4\x49\x0c\xcb\xf3\xf1\xf9\x0c\xcb\xf3\xee\x5b\x19\x96\xe7\xd7\x7b\x19\x96\xe7\xd1\xe7\x0c\x16\xb0\x3c\x5f\xae\x63\x58\x9e\x17\x6f\x60\x58\x9e\xff\x6e\x65\x58\x9e\xe7\xbe\xc4\xb0\x3c\x9f\xfd\x8c\x61\x79\xde\x9a\x3c\x44\xc0\xf2\xfc\x34\x97\x61\x79\x1e\xea\x60\x58\x9e\x6f\xfe\x93\x61\x79\x5e\xf9\x2a\xc3\xf2\xfc\xf1\x08\xc3\xf2\x3c\x31\x1e\xf4\x36\xc4\x10\x3a\x3f\x3b\x9d\x61\x79\x1e\x36\x68\x28\xd7\xc7\xe7\x51\x13\x19\x96\xe7\x4b\x15\x0c\xcb\xf3\xa2\x4d\x0c\xcb\xf3\x9f\xe7\x19\x96\xe7\x39\x3f\x32\x2c\xcf\x67\xd2\x8d\x02\x96\xe7\x2d\xf7\x33\x2c\xcf\x4f\x12\x86\xb1\x3c\x86\x2f\x63\x58\x9e\x6f\x7c\xc0\xb0\x3c\xaf\xb0\x0e\x17\xb0\x3c\x7f\xf0\x32\x2c\xcf\x13\x9e\x60\x58\x9e\x0f\xfc\xc0\xb0\xdc\xef\x0f\x3c\x4b\xc0\x72\xff\x9e\xcd\xb0\xdc\x8f\xbb\x18\x96\xfb\xeb\x3b\x19\x96\xfb\xe5\x37\x19\x56\xf7\xbf\x23\x04\x2c\xf7\xb3\x53\x18\x96\xfb\xd3\xe5\x0c\xcb\xfd\xe6\xf3\x0c\xcb\xfd\xe3\x8f\x0c\xcb\xfd\xe0\xa0\x91\x02\x96\xfb\xbb\x65\x0c\xcb\xfd\xda\x2b\x0c\xcb\xfd\x57\xc2\xd9\xac\x5f\x86\x2f\x62\x58\xee\x8f\x1e\x62\x58\xee\x77\x7e\x65\x58\xee\x5f\xa6\x9c\x23\x60\xb9\x1f\xa9\x67\x58\xee\x2f\xde\x60\x58\xee\x17\xce\x18\xc5\xf6\xce\xf1\xbf\x87\x61\x19\xcf\xff\x87\x61\x19\x9f\xff\xc8\xb0\x8c\xb7\x47\x8c\x16\xb0\x8c\x9f\xcd\x0c\xcb\x78\xf8\x72\x86\x65\x7c\x7b\x03\xc3\x32\x5e\xfd\x0f\xc3\x32\xfe\xfc\x84\x61\x19\x4f\x76\x3b\x57\xc0\x32\x3e\x4c\x61\x58\xc6\x7b\x8b\x18\x96\xf1\xdb\xad\x0c\xcb\x78\xec\x3d\x86\x65\x7c\xd5\xeb\x3c\x01\xcb\x78\x69\x2a\xc3\x32\xfe\xa9\x66\x58\xc6\x33\x1b\x18\x96\xf1\xc9\x03\x0c\xcb\x78\xe3\x75\x86\x65\xbc\x70\x98\x61\xb9\xfe\x9f\x3a\x46\xc0\x72\x3d\x4f\x47\x58\x73\xde\x6f\x43\xb8\x7b\xe8\x3d\x2d\x07\xc2\x09\xa1\xf7\xbd\x96\x22\xdc\x23\xf4\xfe\xd6\x06\x80\x87\xc0\x9e\xab\x95\xcf\xff\xb7\x32\xbc\x8f\xe1\x57\x91\x5e\x73\x7f\x60\x3f\xc3\xf2\xfe\xc0\x6f\x0c\xcb\xfb\x03\xfd\xc7\x02\x3f\xc4\x60\x03\xf8\xbb\x09\xe7\x00\xdc\x47\x13\x3f\xd8\x18\x96\xf1\x43\x0d\xc3\x32\x7e\xb8\x19\xf9\xff\x16\x7a\x8f\xe1\x49\x86\xe5\x7b\x10\x6f\x33\x2c\xdf\x4b\xf8\x0e\xf9\x35\xf1\xc5\x29\xe3\x00\xd6\xc4\x17\x43\x00\x1e\x02\x3e\x55\xe1\x78\x21\x95\xe1\x04\x86\x2f\x64\xb8\x2f\xc3\xf3\x90\x5f\x13\x8f\x78\x19\x96\xf1\xc8\x93\x0c\xcb\x78\xe4\x7f\x0c\xcb\x78\x64\xd4\x78\xe6\xe7\xf5\x7a\x01\xc3\x32\x1e\x59\xc3\xb0\x8c\x47\xee\x67\x58\xc6\x23\x7b\x18\x96\xf1\x48\x90\x61\x19\x8f\x0c\x4d\xe6\xfa\x19\xce\x60\x58\xc6\x23\x73\x18\x96\xf1\xc8\x0a\x86\x65\x3c\x72\x17\xc3\x32\x1e\x79\x89\x61\x19\x8f\x7c\xcb\xb0\x8c\x47\x4e\x4d\x11\xb0\x8c\x47\xb2\x18\x96\xf1\xc8\x52\x86\x65\x3c\xb2\x8d\x61\x19\x8f\x7c\xc8\xb0\x8c\x47\x4e\x30\x2c\xe3\x91\x81\x13\xb8\xbd\x32\xbe\x60\x58\xc6\x23\xc5\x0c\xcb\x78\x64\x15\xc3\x32\x1e\xf9\x07\xc3\x32\x1e\x79\x9e\x61\x19\x8f\x7c\xca\xb0\x8c\x47\xba\x4f\x14\xb0\x8c\x47\x4e\x07\x38\xd1\x20\xbe\x7e\x85\xf0\xb9\x13\x29\x55\x3f\x07\x64\x12\xb0\xfa\xf9\x95\xf9\x02\x36\x48\xb8\x58\x07\x2f\xd6\xc1\x1b\x74\xfc\x0f\xeb\xe4\xbf\xa4\xa3\xdf\xa7\xc3\x7f\xaf\x83\x63\x26\x85\xd3\x1b\x27\x85\xe3\xa7\xe8\xf0\x73\x27\x85\xd7\x5f\xa9\xc3\x5f\xa7\xe3\x7f\x58\x07\xef\xfe\xff\xd8\xbb\x12\xb0\xa6\x8e\xed\x9f\x04\x44\x59\x94\xa7\x88\x22\x8a\xc6\xa2\x56\xb6\xe4\x26\x21\x0b\x58\x15\x64\x97\x2d\x82\xa8\xa0\x10\x42\x12\x20\x2c\x49\x4c\xc2\xe6\xca\x52\xa1\x2e\x2d\x2d\xb8\xd6\xbd\x58\x97\x8a\x75\xab\x68\x5d\xaa\x3e\x97\x5a\xa9\x7b\xb1\x8a\x15\x1f\xd6\xb5\x68\x5d\x2a\x55\xac\xcb\x7f\x6e\x6e\x02\xf7\x4e\x6e\x02\xfd\xbf\x7e\xef\xf5\x7d\x5f\xae\x9e\x2f\x9c\x99\x33\x67\xe6\xce\x9d\x39\xb3\xdc\x7b\xe6\x07\xf1\xf7\xa1\xf4\x14\xae\x8e\x69\xff\x4e\xa6\x3f\x97\x18\xef\x0d\xf1\xe1\x10\x2f\x82\x78\x2d\xc4\x2f\xe4\x12\xf3\x5f\x0b\xf1\x07\x21\xf9\xeb\x10\x6f\xcd\x23\xde\xff\x60\x1e\xb1\xbc\x7e\x18\xdf\x7e\xbc\x51\x28\xc4\x27\x43\xfc\x74\x88\xff\x00\xe2\x97\xf3\x88\xe5\xdb\x0f\xe5\x77\x01\x92\xff\x19\xe2\x7f\x87\xf8\x1e\x7c\x22\xef\xc5\x27\xea\x1b\x03\xf1\x71\x90\xfc\x34\x88\x97\x40\x7c\x36\x94\x7e\x96\x8e\x0f\x68\xe7\x2b\xa1\xf8\xcf\x20\xfe\x00\xc4\x5f\x84\xf8\x87\x10\x4f\x13\x10\xf9\xde\x10\x3f\x02\xe2\xfd\x20\x3e\x06\xe2\x33\x05\xc4\xfb\x29\x84\xf8\x6a\x88\x5f\x01\xf1\xeb\x20\x7e\x23\xc4\xef\x86\xf8\x23\x10\x7f\x0a\x2a\xcf\x65\x88\xbf\x23\x20\xb6\x07\x1b\x3f\x62\xfa\x5e\x10\xdf\x0f\xe2\x07\xfb\x41\xcf\x1b\xe2\xa7\x40\xbc\x0a\xe2\xe7\x43\x7c\x0d\xc4\x1f\x81\xf8\x2b\x10\xff\x18\xe2\x7b\xfa\x13\xf9\x77\x21\x9e\x07\xf1\x11\x10\x3f\x15\xe2\xd5\x10\xbf\x08\xe2\xd7\x40\x7c\xad\x3f\xb1\x7d\x1e\x81\xe2\xaf\x40\xfc\x1d\x88\xff\x0d\xe2\xed\x47\x11\x79\x26\xc4\x07\x40\xfc\x14\x88\xcf\x84\xf8\xc2\x51\xc4\xe7\x57\x3c\x8a\x58\xde\x55\x90\xfc\x01\x88\x3f\x03\xa5\x6f\x86\xf8\xe7\x90\xbc\xd5\x7b\xc4\x78\x7b\x88\x77\x82\xf8\x81\x10\x3f\x0c\xe2\x07\x00\xcd\xae\x80\x42\x01\x85\x01\x0a\x07\x14\x01\x68\x3c\xa0\x48\x1a\xf6\x9d\x59\x0c\xa0\x58\x40\x42\x40\x13\x00\x25\x01\x9a\x0a\x28\x15\x90\x18\x90\x84\x86\x7d\x37\x26\x03\x94\x0e\x28\x03\x90\x1c\x50\x16\xa0\x6c\x1a\x86\xaf\x91\x0b\x48\x01\x48\x09\x48\x0d\x28\x0f\x50\x3e\xa0\x02\x40\x45\x80\x66\x00\x9a\x05\x68\x36\xa0\x12\x40\x3c\x40\x7c\x40\xfe\x80\x46\x03\x42\x6b\x34\x08\x50\x30\xa0\x10\x40\x71\x80\xe2\x01\x4d\x04\x94\x00\x68\x12\xa0\xc9\x80\xa6\x00\x4a\x04\x94\x0c\x28\x05\x90\x08\xd0\x4c\x40\x73\x01\x15\x03\x2a\x05\x54\x06\xe8\x7d\x40\xf3\x00\xa1\x5f\x7c\x96\x63\xb5\x1b\xe0\x02\x7e\x07\x02\x12\x00\xf2\x03\xf4\x1e\xa0\x31\x80\xc6\x02\x8a\xa6\x61\xe7\xea\xa7\xe9\xef\x35\x93\xe4\xfe\xd0\x7b\x53\x01\x9a\x0e\x48\x03\x48\x0b\xa8\x10\xd0\x1c\x9a\xa5\x9e\xd1\x7a\xa6\x53\x98\xa8\x23\x87\x86\x89\xe1\x8b\x31\x33\xe4\x5a\xa6\x0e\x39\x49\xa6\x6e\xff\xc5\x43\x5d\x32\x72\xcd\x24\x08\x96\xa9\xe5\xf9\x32\x1d\x30\x7a\x7b\xd8\xb8\x3c\x79\x8e\x94\xa9\x43\x3e\xcf\x95\x49\xe5\x40\x85\xc6\x10\xc7\x48\xd3\xc5\xc5\x61\x9f\xb5\x43\xa1\x7a\xfc\x76\x1f\x05\xea\x8e\x98\xc3\x2c\x14\xf0\x44\x3c\x5f\x26\xbe\x24\x4a\x8a\xcf\x54\x83\x47\xe3\x48\x32\xdc\x4e\x0f\xba\x48\x04\xe3\xaa\x26\xeb\x12\xe1\x1d\x6a\x46\x92\xa1\x7b\x7a\xd0\x95\x38\x94\x53\x3c\xb2\x6a\x32\xc5\x6b\x2a\xae\x14\x74\x72\x44\x71\x34\x1b\xbc\x94\xee\xeb\xeb\x70\x59\x8e\x6a\x9c\x58\xa1\xc0\x4a\x81\x8f\x36\x0f\x85\x0c\x4b\xa3\x1f\xf3\xa3\x9f\xb3\xa3\xe8\xea\x09\x71\x51\x46\xf1\x24\x48\xfd\x3a\xf8\x66\x14\x4d\xdc\xdf\x80\x98\xee\x9f\x2b\xd3\x68\x80\x92\x76\xcc\x75\x0d\xea\x0d\x64\xa4\x0b\x83\x7c\x85\x55\x85\xa9\x95\x79\xaa\x88\x60\x23\x69\x32\x78\x5b\x58\xe6\x4f\x83\xe6\x76\x51\x81\x09\x50\xdf\x64\x8a\x48\x24\xe2\xb1\xfe\x2d\x15\xa2\x34\x9d\x9b\x82\x5c\x91\xaf\xcc\x96\xfd\xd5\xfa\xc0\xea\x0a\x68\x44\xdd\x22\x44\xa0\xda\x54\x32\xb5\x3e\x16\x0d\x95\xca\x34\x5a\xb5\x92\x24\xc2\x58\x9c\xcd\xe7\x9b\x4c\xc1\xe6\x0b\xfe\xea\x52\xb3\x05\x26\x8a\xcd\x16\x70\x4c\x97\x43\xe0\xdb\xe5\x67\x49\x8a\xb8\x0c\x37\x85\x09\x79\x72\xad\xbf\xc2\x9c\x04\x8a\xf5\xd6\xd5\xd6\x9b\x56\x24\x4b\xa6\x84\x05\x05\xa1\xbe\x55\x32\x95\x56\xa4\xf3\xdc\x63\xb1\x28\x22\x03\x70\x63\x20\x66\xa1\xf4\x76\x26\x98\x22\x32\x2e\x7a\x42\x44\x47\xac\x48\x7f\xdb\x52\xbd\x83\x8c\x52\x2d\xd2\xe6\xaa\x4c\x85\xb3\xf9\x7e\x26\xa3\x04\x5c\x8a\xb1\x17\x30\x1e\x80\x98\xe8\x31\x48\x88\x11\xa5\x93\x41\x1a\x8b\x24\x79\x6a\x35\xd4\xd3\x28\xa8\xcf\xe8\x7f\xd9\xc0\xeb\x8a\xa0\xa4\x88\xe2\x23\xc2\x26\x86\xc4\x45\x8b\x32\x31\xa4\x7a\x8a\xce\x9f\x95\x22\x12\xe3\xee\x8c\x19\x25\x4f\x43\xc1\x0d\x99\x41\xa8\x1b\xb3\x86\x29\x51\xe6\xea\xf1\x40\x0b\xd3\x34\xcc\x78\x65\x9e\x5a\x82\x16\x45\x2d\xc9\x91\x6b\x65\x86\x5f\x1f\x1e\x17\xdc\x18\x1a\xc5\xa4\xe8\x83\x18\xb9\xe0\x96\x71\xbe\xad\x1a\xe6\x14\x14\x8e\x1f\xd5\xc5\x44\xa1\xf0\x41\x2d\x69\xc0\x9d\xe7\xcb\x72\x50\xe7\x28\xe6\x44\xa5\x32\x47\x92\x09\x4a\xa3\x97\xd3\x9b\x7c\x46\xa1\x44\x6b\x88\x61\xe6\x69\xd4\xcc\x1c\x79\x1a\x9a\x29\xfa\xab\xcf\x08\xdc\x83\x44\xa9\x29\x64\x88\x47\x1a\x72\x56\x7a\x80\xe7\x93\x14\xc5\xe2\xe2\x00\xa0\x51\x88\xef\x7c\x5d\x30\x07\x69\xf7\x5c\x12\x65\x2a\x95\xd9\x78\x67\x3b\x15\xea\x8d\x17\xc9\x62\x01\x95\x99\x7a\x4f\x99\x1c\x2c\x91\xaf\x48\x64\xc8\xcf\x94\x0b\x9f\x5e\x3d\xa7\x13\x49\xa5\x4a\x88\x49\xb2\xfc\x30\x47\xa7\x1c\xf1\x8c\x22\x91\x4a\x29\x47\x9f\xb5\x06\xca\x5d\xc8\xc7\x64\xb4\xb9\xba\x24\xbe\xec\x0e\xe5\x7a\x6f\x5e\x11\x01\x93\x1c\x37\xa6\x0a\xf9\x7a\x09\x21\x8b\xa3\x2b\x88\x46\xef\x1f\x89\xa9\xe2\x72\xf0\xaa\xf0\xbe\xb9\xa2\x76\x88\x72\x91\x31\x06\xba\x90\xe5\x4b\x94\x86\xb5\x0b\x59\x2c\x1d\x8f\x15\x4b\x5f\x6c\x1e\x3e\xaf\x0e\xaf\x5d\x91\x89\xe9\x80\x90\xc5\xc6\x8b\x99\xcf\x02\xcb\x81\x8f\xcf\x21\x56\x0d\xaa\x0e\xe8\x93\x69\x4d\xd7\x0e\x9a\x45\x87\x9c\x89\x3a\x12\x90\xd4\x11\x4e\xb9\xf9\x8a\xf2\x23\x49\xd2\x95\xda\x22\xcd\x15\x57\x69\xb8\x6c\xd3\x8d\x6b\xce\x8f\x24\x89\xf9\x5c\xe3\x7d\x45\x58\x7b\x14\x40\x1d\x03\x74\x1e\xf2\xce\xc0\x23\x34\x71\x83\xcb\x23\xe4\x15\x4c\xcc\x44\x18\x29\xd1\x37\x7b\xb6\x2f\x69\xea\x7c\x31\x54\x2c\xa1\x1f\xe6\x20\x0a\x22\xe2\x11\xac\x84\x6c\x96\x51\x52\x74\xa8\x24\xab\x44\x48\xd4\xe0\xf0\x49\xd2\x74\xd8\x3c\x52\x49\x9d\x0f\x25\x99\x38\xdb\x48\x1c\xeb\xdb\x5d\xd0\x8c\x37\x05\xc6\xe2\x1c\x63\xcd\x90\x8f\x2a\x59\x22\xbe\xe9\x3c\x70\x1e\xad\x64\x5d\x06\x36\x54\x46\xd9\x75\x92\x1e\x36\x89\x66\x5d\x6a\xbb\x52\x3d\x1a\x34\x65\x3c\x98\xe0\x28\x32\x84\x50\x13\x35\x34\x00\x36\xd4\x44\x35\x05\xf2\x74\xed\x24\x16\x69\x33\x25\x1a\xcb\x04\x85\x54\x19\x2d\x56\x80\x94\x6a\xa1\x5a\x59\x58\x24\x92\x6b\x22\xe5\x0a\x69\x6c\x3a\xe6\x3f\x4c\x6c\x7a\x46\xfd\x05\xc1\x7c\xaa\x75\x4e\xc0\xba\xbe\xc2\x21\xf8\xa4\x13\x04\x8c\x52\x87\x1a\xf5\xb7\x78\xb6\x68\x46\x88\x30\x9e\x27\xd2\x8f\x48\x84\x0e\xaf\x2b\xa4\x54\xdf\xc3\xd1\x85\x90\xf9\xc2\x61\xf7\xca\x32\xad\x42\xe7\xcf\x8a\xce\xd0\x42\xc0\x34\x45\x5b\x64\x5e\x9b\xa1\xa6\x39\xbc\x4e\x14\x8e\x43\xc7\xa5\x4e\xaa\x0d\x28\x03\xff\xb3\x8c\x1a\x6a\x4c\x7c\x64\x64\x74\x3c\x98\x42\x69\x30\xb3\x89\x0e\x00\x0a\x65\x81\x02\xd8\xb2\xae\x95\x8e\x2d\x30\x52\x26\x37\x28\xea\xb2\x0e\x3f\xa2\x0e\x4d\x30\x61\x38\xea\x62\x35\x71\x8d\x94\x60\x6b\xbb\xd8\x3f\xaf\x8a\x63\xa4\x0a\x36\xf4\x9d\x2a\x32\xe8\x22\x36\x07\x9d\x2e\xd0\x71\xc0\xf3\x57\xcb\x65\x1a\x74\x81\x8b\x1f\x1b\xcc\xb7\x7c\xc2\x48\x6c\x54\xf9\xc6\xc7\xb9\x10\xbb\x42\x24\x8b\x8b\x59\x71\x5d\x97\x95\xa3\x67\x0a\x91\x9a\x3a\xa3\xf3\x6d\x20\x35\x92\x5c\xb2\x74\x24\x87\xc2\x90\xd9\x19\xd8\xd0\x19\xdc\xf9\x81\x5a\x4c\x00\xb6\xa1\xf8\x53\x0e\x0c\x42\x1c\x78\x40\x81\xcf\x35\x68\xd7\x06\x9b\x35\xdc\x09\x06\x26\x65\x70\xa7\x27\xb4\xcb\xe0\x8a\x4d\x38\x1f\xc8\xd8\x1a\xe1\xd5\xc1\x27\xe2\x08\x05\xed\xc7\x32\x90\x8e\x17\xe4\x27\x04\xa1\xc3\x35\xd6\x6f\x59\x7c\x2d\x0a\x8f\x0e\x66\x17\x69\x7a\x63\xa7\x81\xac\x1d\x37\x03\xd8\x77\xc3\x69\x1c\x4a\x91\x56\x3f\xb9\xed\x48\x66\x28\x40\x97\x12\xb2\x11\x42\x42\xf4\xe0\x04\xd0\x66\xbb\x94\xa5\x6f\xc7\xae\x11\x68\x70\xaa\x2e\x98\x4d\x30\xe5\xd1\x37\xbc\x68\x71\x51\x9a\x2c\x1e\x1d\x4d\x84\x2c\x81\x4e\xbb\x6e\x68\xd1\xe7\x61\x98\x54\xb0\xd1\xda\x8a\xc7\xc6\x1c\x60\x13\x33\x72\x64\xba\x53\xc1\x40\x6d\xa5\xe9\x87\x05\xa9\x2c\x97\x2c\x9c\xa7\x91\x88\x15\xfa\x14\xa1\x72\x59\x8e\x34\x0e\xc4\xa1\x1d\x96\x25\x8a\x93\xff\x99\x75\x92\x30\x47\xac\x05\xc6\x20\x57\xc3\x8c\x16\x4b\x62\xe3\xa7\x30\x54\xfa\x00\x9c\x4c\x7c\x70\xa4\x21\x9a\x85\x30\x58\x2c\x86\x6e\x19\x09\x56\xda\x0c\x8d\x34\x5b\xb7\x8a\x92\x2b\x24\x39\x79\x52\x19\x53\xa2\x2d\x52\xc9\x18\x99\x86\x75\x91\xbe\x5d\xe4\x29\x3a\x2a\x52\xaa\x3b\xf0\x01\x2d\x98\x7e\xd1\xc2\x96\x14\x16\x06\x19\xce\x16\x21\xeb\x6d\x48\xba\xbc\x10\x3b\x86\x00\x34\x26\xf4\x40\x1c\x74\x5d\x6b\x24\xa8\x50\x2a\xb0\x85\x8f\xae\x82\x65\x1a\x7d\x1f\x83\x96\xe3\x71\xb1\x60\xdd\x8d\x5b\xc5\xe9\xbb\x2b\x6e\x59\x4e\x2a\xc1\x61\xeb\x24\x86\xe9\x65\x80\xbe\xf0\xd8\xe0\x78\xa2\x98\xd1\xc2\xdf\x28\x1b\x5f\xc2\xea\x1f\x8e\x66\xb1\x0d\x8f\x3a\x0a\x3d\xc9\x01\xeb\xb1\x60\xc5\x45\x5c\xed\x75\x74\xd2\x3f\xbf\xf0\x0c\xc1\xd6\x7e\x58\xdd\x84\x4d\xfa\x37\x15\x74\x6d\x7e\x6f\x94\xe7\x9f\x4e\x04\x4a\x49\x32\xd9\xd2\x8a\xd5\xed\x87\xa8\x60\x83\xaf\x52\x2d\xcf\x40\x77\x7f\x48\x67\x3c\xd8\x20\x86\x98\x94\x81\xa6\x34\xfa\xa1\xd8\xbc\xb8\x6e\xc2\x82\x65\xce\x25\x5b\x56\xe1\xcc\x29\x5b\x77\x3e\x18\xee\xe8\x27\xfc\xcc\xcf\xd7\x70\xf2\x1d\x2e\x90\xc3\xc3\xe5\x6d\x72\x6e\xa3\x6f\xbd\xb0\x28\x6e\xe6\xa2\x1f\x5f\x09\x12\xd0\xbc\x44\xbf\x1d\x01\x8b\x18\xcf\x3a\xf4\x3d\x01\x16\x84\xe7\x14\xc6\x55\x6d\x76\xba\x80\x35\xa5\xae\xce\xc3\x8d\x9a\xd3\xff\x2b\x21\xb0\x0b\xed\xc5\x23\x9f\x2d\x90\x48\x91\x1d\x18\x87\x3d\x5c\xa2\x2e\x62\xb3\xe4\x19\x45\x76\x1c\x77\x84\x35\x6e\x23\x01\xe2\xe1\x46\x58\xfb\x22\x0a\xe1\x8f\x30\x22\x8b\xc7\x1f\x9d\x04\x15\x91\x78\x30\x20\x94\xd8\xe8\xf0\x3b\xa8\x29\x9a\x19\xdf\x75\xa2\x7e\xc1\x86\xc1\xca\xf2\xce\xc7\xf2\xce\xc7\xf2\xce\xc7\xf2\xce\xe7\x2f\x7d\xe7\x63\xea\x6d\x83\x65\x6b\xde\xb2\x35\x6f\xd9\x9a\xb7\x6c\xcd\x5b\xb6\xe6\x2d\x5b\xf3\x96\xad\x79\xcb\xd6\xbc\x65\x6b\xde\xb2\x35\x6f\xd9\x9a\xb7\x6c\xcd\xff\x2d\xb7\xe6\xff\xd3\x3b\xe3\x64\x1f\xca\xe9\x1e\xb5\x6e\x99\x64\x38\x7f\x5c\x61\xd8\xed\x61\xb1\xf4\xd5\xd0\x11\x42\x94\x44\x37\xf8\xff\x26\x9f\xda\xfd\x0d\xf7\xfc\xbb\xf8\xb1\xdf\x5f\xfe\xae\xa0\xeb\x1f\x0f\x12\xbe\xcd\xb3\xbc\x64\xb0\xbc\x64\xb0\xbc\x64\xb0\xbc\x64\xf8\x1f\x7d\xc9\x40\x76\x0e\xbd\x89\xd3\xeb\x4d\xe0\xe7\xe8\xe1\x52\xf0\x38\x29\x5d\x01\xed\x32\x07\xb9\x43\x0e\x91\x63\x02\xe1\x8b\x14\x35\x87\x14\x28\xc7\x3c\x06\x98\x19\x08\x1d\x33\xc8\x60\xe4\xc0\x40\x9d\x43\x84\x99\x05\xe2\x31\x87\xc1\xd3\x39\xec\x8e\x09\xe4\x31\x13\xc0\x5b\xa4\x18\x64\xe6\xf0\xc7\x4c\x40\x8e\x91\xa2\x8c\x99\x40\x18\x23\xc3\x02\x32\x8b\x04\xd4\x29\x1c\x59\x17\x11\xc8\x4c\x63\x34\x99\x81\x1e\x23\xe0\x79\x98\x00\xf2\x20\xc3\xb7\x32\x01\x69\x05\x83\x7d\x74\x8e\x6a\x64\x02\xed\xcc\x18\x1e\x84\x04\x2b\xcb\x18\x27\xab\x0b\xe0\x21\x24\xd0\x69\x64\x90\x69\x1d\xe0\x22\x7a\x54\x11\x03\x9c\x88\x11\x40\x12\x09\x32\x12\x09\x24\x92\x49\x2c\x24\x13\x20\x48\x66\xd0\x8f\x48\x61\x8f\x60\xa3\x4a\x06\x7f\x44\x82\x7b\x44\x0a\x78\x64\x1a\xe9\x08\x06\x51\x31\x83\x9e\x82\xc1\xa6\xe8\xf1\x52\x30\xa0\x14\x02\x42\x0a\x01\x1a\xc5\x80\x89\x42\x84\x50\x82\xb1\x93\x3a\x43\xbc\x32\x40\xa9\x18\x30\x54\x0c\xe0\x29\x9d\x81\x2d\x99\x46\x59\x32\x39\x32\x1b\xed\xe1\xea\x61\x97\x4c\xbe\x11\x32\x8e\xc1\xe3\xff\x18\x46\x35\x18\x61\xc9\x10\x0e\x43\x2b\x99\x1f\x05\xb1\xd6\x4c\x02\xb8\x04\x4f\x0e\x48\xa6\x03\xc6\x63\xb7\x89\x29\x01\xb6\x97\x43\x82\xa6\x44\x32\x3d\xd0\x85\x18\x40\xf9\x48\x36\x8a\xda\xc3\x60\x84\x25\xc2\xb6\x3a\x05\xbf\xb7\x4c\x31\xb1\xd1\x6c\x2a\x5c\x0a\x57\xb7\x0c\x8f\xb3\x64\x72\x6e\xa5\x24\x02\x2f\x41\x6f\x29\x28\x06\x3c\x1e\x73\x90\x4b\x9d\x60\x2d\x91\x4c\x78\x48\x50\x7d\xc8\xe1\x7c\xf0\x00\x4d\x06\x4c\x1f\x03\x98\x8f\x01\xc5\xa7\x1d\xbe\xc7\x08\x41\x10\xba\x5e\x36\x39\x1c\xa1\x50\x9c\xde\x50\x3a\x7c\xd8\x75\xf0\x22\xe0\x7f\x20\x76\x0a\x08\xed\x35\x90\x41\x79\xb7\x76\x90\x11\x0a\xa5\x08\x90\x2f\x26\x47\x71\xa6\x50\x43\x8a\xfb\x52\x29\x0e\x38\xb5\xa1\xe9\x6a\x06\xe6\x83\xc6\xd0\x7b\x8e\x51\x62\x26\x44\x45\x8d\x8f\x64\x87\x45\x72\x28\x5f\xce\xbc\x22\x19\x14\xf7\xeb\x85\xf3\x77\x0f\xca\xe3\xef\x6c\x3c\xf6\xf3\x16\xe5\xdd\xb1\x1e\x53\xee\x0f\xe4\x48\xd2\x82\xa7\x27\xd8\x4e\xde\x51\xf2\x4f\xc6\x76\x0e\x37\x65\x68\x03\x75\xc5\x89\x67\x76\x34\xa9\x6f\x7d\x3d\xed\xb3\xea\xd7\x77\x03\xc5\xea\xca\xad\x75\x7e\xef\x84\x5c\x0b\xe8\xe3\x5c\x11\xb6\xe8\xfd\xd2\xa6\x4b\xb1\xa3\xda\xc6\x7e\xf6\x4e\x68\xd1\xeb\xb5\x07\xd6\x5e\xb0\x3a\xf7\x75\xcd\xb0\x07\x9c\xa9\x65\xf9\x95\xb3\xd7\x6d\x18\xc6\x70\x75\xf1\x4e\x79\x3d\x63\x9d\x53\xd2\xd6\x96\x95\x4d\xe9\x99\x0d\xd9\xdf\xba\x3b\x7b\x25\x26\x8a\xbf\xf1\x7c\xbe\xf4\xa3\xd9\xcd\xad\x37\xb7\x95\x55\x2f\xb9\x77\xe4\x75\x62\x9a\x53\x5b\xe8\x83\x20\x37\xf5\xd3\x39\xeb\x18\x89\xaa\xe0\x98\x9f\xaa\xee\x0e\x4f\x70\x8e\x4f\x99\x53\xbb\xe5\x7a\xda\xe8\xb5\x9b\x7c\x86\xf6\x90\x7d\x1d\xd1\x1a\x50\xfe\xe4\xe7\xa7\xce\xe2\x23\xf2\x9f\x64\xa9\x79\x9b\xef\xf8\xbe\xef\x11\xfc\xa2\xee\xf7\xdb\x23\xc7\x51\xe9\x8b\x7f\xb9\xda\xd7\x95\x2f\x2b\x6a\x19\x53\x50\x35\xa2\xd5\x27\x6b\x84\xe7\xb0\x99\x63\x96\xfd\xf0\x4b\xc1\x77\x1f\x1e\x0e\x3d\xe9\x29\x70\xb3\x3b\xfc\xfb\x86\x42\x65\x7a\x45\x45\x81\xeb\xe5\x69\x87\xb6\xf5\x97\x67\x3a\xde\x7c\xbe\xa6\x7e\xfc\xb2\x26\xcf\x26\xbf\xdd\x9a\xd9\x23\x3f\xbe\x52\xb3\x60\xc6\xa6\x7d\x61\x1f\x6d\xfd\x23\xf0\x93\x4f\x76\x9e\x3c\x9c\xe5\x17\xee\x3a\xbd\xf7\xa0\x38\xf7\xd6\x0b\x63\x95\x2e\xdc\xba\xf2\x41\xce\x2f\x76\xac\x16\x71\x7d\x97\x54\xf7\xe7\xdf\x3e\xb9\xa2\xc7\x11\xb5\x36\xa0\xb9\xf6\xfe\xce\xd4\x7f\xee\x9f\xcb\x69\xd8\x7b\x7c\xe3\xa3\x01\x7d\x7e\x1a\x3b\x69\xb3\x8b\xdd\x50\xe7\x9d\xd4\xbb\x42\x56\x48\xd1\xec\x06\xca\x37\xb7\x72\x2f\x7a\xdd\xd0\x4a\x8f\xa4\x1d\x28\x7f\xa8\xf5\x5f\x12\x3c\xe1\x63\xde\x7d\xc1\x17\x15\x3b\x92\x42\xbe\x6c\x3b\x38\x46\xfb\xe9\xe6\x1e\xa5\x37\xc2\xdc\x63\xc3\xba\x57\xf4\xb8\x7f\xca\xbe\x74\xd8\x84\x8b\x72\x97\x9a\x65\x33\x52\xbf\xdd\xbc\x2f\x62\xe8\x2a\xa7\xbb\x97\x5e\xfd\x34\x43\xb8\xdb\x76\xda\xa2\xb7\x4a\xe7\x58\x91\xdd\xa6\x98\x5b\x3f\xfa\x1f\x5d\xf0\xf8\x17\x1b\xf5\x2d\xd0\x38\xd0\x66\x72\x90\x82\x1d\x1a\x83\x36\xa2\xbe\x20\x0c\x6d\x04\x9b\xf5\x61\x36\xfa\x86\xd2\xdb\xb8\x51\xe8\x2e\x34\xde\x51\xff\x6b\xc0\xc1\xb1\xd3\xe4\xe9\x3a\x1d\x23\x28\x46\xc7\xa3\x7a\x7c\xa3\xc5\x12\x7a\xfb\x07\xa8\xfe\xf4\x7c\x54\x8b\x8c\x91\x25\x03\xd3\x0c\x99\x36\x40\xaf\x3a\x5d\x4d\x1f\x19\x94\xc8\x13\x84\xf2\x22\xc3\xf8\xbe\x1e\x20\x5d\x2f\x7d\x7a\x3b\xcf\xf2\xf0\xf2\xe7\x12\xa9\x0d\x8d\x6a\x68\xfc\xf6\xe0\xaf\xde\x54\xa4\xd8\xc6\x56\x17\xd7\x93\xda\x9d\xb6\xae\x18\x29\xa6\x51\xa9\x2c\x47\xa4\xa7\x8d\x6d\x6a\x79\x38\x55\x66\x65\x4d\xa3\x76\xa3\xe0\x85\x40\xaa\x75\xa5\xbd\x66\x23\xa5\xd6\xee\x48\xa9\x95\xfd\x3a\x2b\x1a\x95\x46\xa3\x0e\x40\x53\xe8\x45\xa8\xdd\x40\x8a\x34\x96\x3d\x62\x6b\x63\x95\x60\x6d\xe3\x44\x4b\x88\x67\x39\x21\xbd\x51\xc6\xce\xc9\x0e\x5d\x1a\xc8\xe8\x11\x0a\x09\x83\x35\x02\x19\x86\x06\xda\x3b\xb9\x61\x81\x41\xe8\x7e\xa7\x61\x92\x47\x07\xb6\x2c\x13\x2c\x82\xb4\x45\xac\x7e\x48\x5f\x54\xce\xca\xa9\x27\x26\x17\xa7\x54\x6a\xe9\x41\x81\xc8\xe0\xfe\x3d\x11\x01\xc2\x66\xf9\xb2\x04\x5c\x1e\x87\x9b\xd4\xbf\x27\x8b\x87\x63\x91\x92\xa5\xe4\x85\x70\xc0\x17\xc2\x1b\xf1\xd4\x15\xc2\xc1\x1d\x0b\x04\xb3\xc8\x1c\x69\x81\x5c\x2a\xeb\xa8\x6e\x7a\x9c\x2c\x07\x1b\x39\x59\xc1\xc8\x38\x5d\x51\x1c\x46\x75\x41\xda\xd4\x0d\x21\xa5\xd4\x77\xf0\xf5\x05\x6a\xd8\xaa\x94\xea\x48\x01\xe1\x76\xb4\x52\x2a\x95\x72\x5a\x30\x71\x63\xfd\xa4\x2f\x4e\x0d\xf3\x0b\xbf\xbf\xe8\xd7\x07\xa2\x7d\x73\x47\x7c\x72\xd0\x37\x7e\xc6\xd9\x59\x92\x62\x71\x81\x34\xe5\xf3\xee\xee\x75\x7e\x6f\x84\x3e\xce\x93\xa6\xa8\x7c\xe6\x1c\x57\x1c\x1d\x3f\x6f\x0c\xf3\x71\xd3\xfb\xae\xeb\x27\x7f\xb7\x7d\xa6\xcb\xdb\xac\x97\xce\x7d\x22\x9c\x7b\x0c\xa8\xfd\xe2\x9a\x27\xfb\xe1\x52\x49\x66\x9c\xf3\xe8\x45\x0b\x1e\x1c\xce\x76\x59\xf2\x2f\xab\x5a\xea\xec\x65\xdd\x32\xce\xf6\x9a\xb7\x84\x9f\xf5\xd0\xb6\xee\xcb\x8c\x33\x13\xa4\x6b\x4f\x4e\xbb\x70\x70\xb2\x22\x83\x5b\x9c\xcf\xdb\x50\x51\xa2\x10\x54\x5e\xf1\x72\x8e\x99\x39\xfc\x4a\xd2\xe1\x98\xb2\xa3\x1f\x5e\xac\x6e\x70\x9d\xbe\x27\x75\xd5\x6a\x4e\x4c\x42\x5b\x9f\x29\x0e\xe5\xfb\xfa\xf5\x3c\x7a\xbb\x20\xe4\x43\x61\xf8\x7e\x2f\x9f\x5b\x8b\xe7\x35\xdf\x3b\xbe\xfa\x64\xe6\xe7\x3b\x6a\x5e\x65\xcc\x51\xed\xa7\x25\x46\x16\x8c\xfb\xc4\xef\xeb\xd6\x33\xcd\x51\x8f\x8e\x8c\x0a\x5f\xb4\xf1\xf6\xd0\xe4\xd7\x59\x59\x07\x36\xf5\xe3\xa6\x5e\xbc\xb3\xed\xb7\x50\x8f\xef\xee\x1d\x5b\x78\xbf\xe8\x48\x96\x38\xc3\x79\xd7\xe3\xd7\xb7\x33\x0f\xa6\x89\x14\xe7\x56\x5c\x7f\x34\x50\xf2\xa6\x4a\xb6\x95\x66\x45\xa5\x50\x6b\x4a\xbe\x44\x4a\xb6\x22\xbd\x40\x7d\xbb\x39\x52\xa9\x6f\xad\xad\x41\x53\x2b\x47\x1c\x51\xde\x09\xe5\xbb\x21\x56\xe0\x07\x71\x43\x03\x7a\x59\xf7\xb3\xee\xfb\xc1\xbb\xfd\x6d\xb7\xd4\xb9\xa4\x2e\x7c\xd0\xb2\xff\xf7\xb0\xc4\x13\x71\x13\x6b\xf6\x22\x43\xd0\x68\x77\x6b\x17\xa4\x5f\x71\x5f\xaf\xf3\xf2\xb0\xc5\xf9\xb6\x6f\x9e\x65\x7f\xc8\x08\xd8\xf8\x3c\x2c\x7a\x6e\x8f\x14\x84\x87\x0a\x0c\xb1\x66\x22\x3e\x88\xd7\x3a\x8f\x75\xef\x96\x0f\xcf\xd4\x6a\x55\xfe\x4c\x66\x41\x41\x81\xde\x31\x57\xa2\xcc\x65\xea\xfe\x92\x88\x99\x6a\xd0\xf8\x18\x12\x75\x0e\xf2\x0f\x1b\x5c\xa7\xb2\xa6\x81\x36\x4f\xec\x02\x56\xe8\x93\x6c\x64\x53\x96\x9e\x98\xb8\xf8\xd2\xa8\x52\x7e\xfa\x6c\x46\x66\xc3\xb1\x93\xfb\xea\x47\x2c\x0a\xff\xf8\x9c\xd3\xb0\xac\xd0\x8f\x24\x3f\x3c\x3e\xef\x64\x73\xfd\xca\xf1\xa3\x7c\xe7\xa7\x1c\x27\xbf\x11\x3e\x67\x19\x09\x01\x3f\xdb\x5b\x6d\x7f\xd9\xe7\x9f\x77\x7b\x0e\xdd\xd7\x50\x7c\xae\x76\x24\xf3\xac\xcf\xee\x98\xed\xe5\x03\xac\x4f\xde\x17\x5e\x8f\xf2\x08\xfb\x87\xfb\xab\x9c\x03\x03\x97\x67\x87\xcf\x6f\x9e\xba\xba\x29\xf4\x1a\x77\x1e\xf7\xd1\x98\xf3\x37\xa7\x44\x0a\x9e\x3d\xcc\x4b\x1a\x32\x4d\xe5\x36\xc9\xaf\xcf\xed\xe6\x9e\xbd\x6f\xcd\x1f\x6e\x73\xa8\xe1\x2b\xe7\x8f\x52\xce\x27\x2e\xfb\xf8\x7e\x64\xc9\xcd\x1b\x2e\xdb\x83\xb5\x83\x07\xac\xf3\x0f\xfb\xfe\x13\x37\xff\x16\xf7\xa4\x37\x9f\xfb\x2c\x7b\x3e\xf3\xc0\xe5\x8a\xd0\x71\xf3\x5a\xe2\x46\x8f\xd8\x25\xd9\xfc\xd5\xce\xd3\x95\xcf\xbe\xbf\xb9\xfb\x54\xd8\xbf\x0e\x7f\x9a\x58\xf6\xb2\x71\x79\xbc\x67\x69\xeb\x2c\x19\x63\xc9\x55\x4e\x72\xeb\x43\xd9\xf7\x2c\xfe\x89\x24\xeb\x7b\xd9\xcd\x77\x3f\xb0\x1a\x9f\xb7\x66\xe5\xc8\xfa\xf1\x01\x1f\xc8\x82\xf2\xae\xd7\xfb\x0f\x77\x3f\xb1\x66\xf2\x0b\xc6\xd9\x8b\x5b\xaa\x52\x34\x0c\xe6\xf0\x3c\x67\x60\x26\x0e\x00\x33\x51\xa3\x37\x13\xb4\xff\x96\x99\xe0\x21\xbe\x6c\x2e\x30\x0d\x08\x87\x07\xcc\x04\x87\x8b\xb0\x11\x3f\x3d\xfb\x1f\x29\x43\x67\x3d\xfe\xd6\x27\x5b\x6c\x87\x7c\x72\x6d\x70\x98\xb0\xa5\xdb\xc3\x94\xa2\xf7\x7d\x5a\x78\x9f\x4d\x8e\x4a\x78\xb0\x70\xc0\xfc\xa6\xb6\xc8\x9c\xd6\xee\x5b\xdf\x41\x68\xf7\x5c\xc6\xbc\x10\xda\x5e\x9a\x1b\xb8\x79\x79\xdb\x85\xd3\xe9\xab\x86\x65\xf7\x3e\x5f\x73\xe0\xd6\x40\xcf\x63\x43\x96\xac\x99\xe1\xb0\x21\x6c\xe1\xd4\x1f\xfa\xf1\x39\xf5\xc7\x1d\xa3\xcf\xf6\x95\x5f\xf8\x6e\x80\xba\x35\xb9\x57\xc3\x5c\xd1\xca\xa7\xc3\xad\xf6\x27\x54\x46\x27\xf7\x7c\x22\xe5\xba\x3b\x47\x3a\x27\xba\xed\x5e\xfc\xfc\xf7\x55\x6b\xce\x09\xf7\x1f\x9d\x22\xcc\xa8\xec\xf1\x15\xfd\x79\x7d\xad\x37\x5d\xa9\xaa\x1b\x4b\x45\x16\xed\x0d\x3a\xe7\xb8\x7a\xf4\x6f\x5e\x11\x23\x5d\x4f\xbd\x39\xbb\xe7\xeb\x5e\x57\x53\x06\x5d\xae\x1f\xbd\xc7\x7f\xdb\x33\xc7\x5e\x94\x2a\xfb\x9d\x43\xfb\x31\xb4\x0d\xef\xf5\xbc\x96\xd6\x6f\x6b\xcd\xf4\xaa\xb0\xf8\x84\xa3\xdb\x99\x81\xbb\x5f\xfc\xf1\xb3\xea\xcc\x9d\x9a\xa8\x90\x39\x43\xa2\xb2\x85\x4b\x03\xe7\x1f\xd7\xa6\xd9\xff\xa3\x2c\xb0\x82\xb3\xa0\x64\x27\x32\xe5\x41\x92\x35\x7b\x51\xe6\xee\x4a\xb7\x26\x99\xe6\x6d\x46\x8a\xec\x60\xc4\x95\xfc\xb5\x9c\xbe\xb2\xf5\x05\x8b\xbf\xf3\xc1\x7a\x7c\x29\x75\x06\xa8\x91\x7c\xa8\xcf\xdb\x98\xeb\xf3\xa4\x5d\xba\xd3\x3e\x5f\x4a\x45\x9f\xb1\x1b\xdd\xba\x94\xda\x03\x30\xd6\x80\x28\xfa\xa7\x23\x91\x76\xa3\x22\x25\x4f\xc1\xa8\xd0\xc3\xcb\x06\xb4\xd1\xee\x34\x6a\xbf\xc1\xa8\x45\xd0\x98\x34\x09\x48\xc9\xb1\x76\x61\x1a\x52\x52\xe7\x5a\xb2\x1b\x8c\x01\x72\x74\xe1\x42\x07\xad\x43\x9b\x29\xd7\xd0\x25\xed\x0d\x06\xfd\x78\x8f\x2e\x56\x14\xd1\x55\x62\x30\x7f\xa6\x83\xd9\x38\x58\xb7\x68\xe8\x62\x09\xfa\x4e\x0a\x4b\x93\x0e\xd2\xc8\x50\x52\x18\x3e\xb7\x05\x8b\x23\x3a\xba\x14\x92\x8a\xd5\x52\x3a\x7a\x64\x01\x48\xa0\x90\xd2\xc1\x42\x49\x2a\xc7\xc6\x1a\x90\x28\x4f\x23\xf3\x26\x64\xa4\x52\x82\xb4\x45\x98\x24\xa1\xc1\xaa\xd4\x62\xb0\xec\x92\xe8\x74\x6a\x75\xcb\x3f\x0d\x83\xcc\x7c\x4d\xe3\xad\x88\xf2\x29\xdc\xfb\x70\xd1\xca\x9f\x7e\x2b\x58\xf9\x34\xff\x62\x01\x12\x7b\x74\xc8\xbc\xb2\x79\x03\x57\x8c\x0e\xe3\x3f\xdd\xb2\x32\xe0\x23\xef\x1f\x76\x7e\xdc\xe7\xca\x37\xcf\x34\x2b\x7f\xbd\x28\x75\xac\x2f\x8a\xf5\xbd\xf2\xd9\x98\xb6\xc2\xb7\xd9\xa7\xba\x3f\x98\xed\x57\xb6\x30\x9e\x7e\x49\x70\x7c\xe7\xe1\x4f\x63\xed\xb2\xdf\x7a\xbd\x4a\xdc\xd4\xcd\x76\x56\xff\x80\x49\xbd\x07\x6b\x2f\xed\x5d\xe8\x3e\x2a\xac\xa6\x41\x39\xec\x66\xcb\x85\xbd\xaa\x1b\x8e\x21\xcd\xef\x9e\x7e\x92\x5b\xf8\xf0\xee\x9e\xfe\xfd\x37\xff\x34\xe7\x1d\xee\xe9\xe1\x0d\x57\x1d\x2f\x67\xff\xf0\xd9\x30\xf7\xde\xcf\xd7\xd7\x56\x6a\x4a\x52\x4f\x0e\x9c\x6a\xcb\xac\xda\xf5\x22\x38\xfc\x71\xaa\x60\x8d\x73\xeb\xe8\x11\x19\xf4\x05\x9c\xac\xe7\x3d\x4b\xcf\x35\xb5\xd4\x30\xdb\xe2\xb3\xa6\x4a\x8f\x48\x38\x05\xcf\xfd\xbb\x7b\x4f\x6a\x69\x74\x1c\xda\xab\x71\xbf\x66\x40\xec\x9e\xab\xbc\xb9\x47\x2b\x12\xae\x6e\x5a\xf1\x35\x7b\xdc\x1f\x57\x1a\xa6\xaf\x99\xb3\x3e\x6e\xef\xc0\x43\xd5\xe3\x86\xf5\xf1\xfc\xd6\xd1\xad\x2e\x7a\x55\x8a\xe4\x54\x64\x71\x7f\xe1\x17\x0b\x1a\x1a\x6f\xbd\x3a\x6f\xdb\x9d\xbf\x23\x2f\x6f\x28\x52\xda\xad\x1a\x98\xb0\x99\x98\xf9\xea\x11\x79\xe3\x66\xeb\xbb\x4b\xbd\x02\x61\x2b\xf6\xb7\x9d\x68\x00\xd3\xc7\xe2\xb2\xd8\xe0\x1f\x82\xf0\x7c\x05\xba\x19\x12\x60\x58\x7a\x16\x2d\xb8\x2b\xe2\x62\x63\x67\x5b\x35\x62\x7e\xf5\x53\x6f\x29\x95\xea\x60\xc7\x89\x61\x8f\xe7\x24\x08\x7c\x05\xe3\x58\xa3\x91\x51\x58\x21\xfe\x5f\xd3\x4f\x43\x35\xd8\x3b\xd8\x75\x2c\x80\x58\xfd\x11\x67\xac\x6e\x7a\x4d\xd2\x29\xa1\x8f\x0f\x09\x8c\x99\x18\x32\x91\x50\x83\x9d\xda\xcb\xa3\x4b\x77\x35\xee\x7a\x5b\x5f\xbf\x88\x35\xe8\x5a\xb7\x8d\xe9\xfb\x5a\x26\x1d\xca\x92\x3f\xb6\xda\xf2\x44\xbc\x80\x33\x9e\x23\x3c\xd0\x66\xcb\x2d\x5d\xba\xc0\x81\x3b\xf8\x51\x52\xa9\x90\x3b\xf8\x8b\x85\x8f\x58\xe5\x1c\xbb\x21\x77\xb5\x7b\x57\xd7\x9e\x76\x19\x74\xe9\xc2\x07\xd7\x0b\x16\xa4\xce\xbf\xf5\xeb\xb6\x1f\x1b\x22\x86\x6f\xf5\x1b\xf7\x72\x44\xad\x26\xf1\x97\x4b\xe7\xc2\x6a\xeb\xbd\xe7\x3b\xdf\x9b\x74\x68\x74\xde\xac\x5b\xbe\x53\xd2\x6c\xd3\x8e\x3e\xfe\xfa\x87\x6e\x5e\x6b\xa2\xab\x67\x3a\xaf\x7f\xab\xb8\xcc\x38\xb6\xbc\x25\xed\x50\xc3\xc2\xd4\xb3\xf9\xdf\x2c\xcd\x62\xad\x55\xae\x28\x8b\x96\xfd\x30\xb7\x69\xe0\xc3\x15\x01\xd3\xeb\xea\x3c\xeb\x1c\x6b\xbc\x46\x3e\xdc\x5f\xd1\xe3\xdb\x73\x63\x46\xfb\x5c\x94\x72\x57\x9d\x5e\xf7\xf6\x9a\x5d\xfc\x07\x8d\x9b\x23\xd6\x4c\x9c\x7d\xcb\xfb\x33\xb7\x6b\x4c\x8f\xa8\x23\xd5\x89\x3d\xee\xec\xb8\x77\x20\x41\x96\x6e\xef\xd7\x5a\x3e\x21\x20\xf5\xf2\x57\xa1\x6b\x15\x1f\x5d\xb9\xea\xd2\xe3\xa7\x31\x2b\xfa\x4e\xdd\xb7\xd2\x4b\xb5\x7e\xf0\xae\x7f\x0d\xaa\xff\x99\x22\x71\x0c\x29\x9d\x33\x77\x6f\xc6\xc2\xa5\x0f\x97\x89\x8f\xbe\xec\x9d\xe0\x68\xb0\x97\xcd\xa0\x46\xae\x23\xa3\x0d\xe6\x87\x4a\xb5\x66\x21\x60\x26\x63\xe0\x11\x6a\xf9\x50\xfd\x6c\x46\x29\xd1\xa8\x70\xb6\x0b\x65\x7d\x0a\x0a\xa4\x6a\x84\x85\xb3\xa6\xab\x0b\x1b\x03\xc6\x14\xdf\x7e\xf6\xe0\x75\xdb\x6f\x57\xca\x72\xc2\x6e\x2f\x7a\x11\x89\x38\xb4\x5b\x5f\x1a\x42\x21\xd8\x56\xd2\x09\x17\x78\x4e\x06\xdb\x6a\x03\x18\x60\x10\xdf\x12\x4c\xeb\x13\xd8\x5c\x3a\xfc\x6f\x9a\x4b\x0f\xdc\x00\xe1\x66\x76\xca\x48\x1c\xcf\xba\x17\x23\xfd\x50\x7e\x38\xca\x3b\x20\x76\x06\x35\x56\x56\x88\x53\xc7\x94\x92\xea\xa0\xab\x70\xf2\x59\x65\x90\xef\xfa\x4f\xdd\x3d\x47\xb7\xce\xca\xf9\x74\xa4\xab\xcd\x09\xdb\xeb\xc9\x97\xba\xef\x9c\xb5\xab\xf9\xab\xea\x0d\x17\xaa\xd5\x5f\xac\xa9\x4a\x3e\x2b\x7f\xdd\xfc\xbd\x87\x38\xee\x6a\xf2\xb6\xc6\xaa\xe7\xae\xc1\x65\x73\xdf\x89\xa9\x0a\x61\xac\xfc\xd1\x6d\xe3\xca\xe4\x6f\x0e\x25\x7b\xa6\x6b\xc3\x9a\x7a\x56\x7e\x5e\x71\x73\xb7\xbd\xf8\xd6\xa5\x3b\x72\xd7\x7e\xb3\xc7\xf1\x96\x15\x3f\xbb\x3b\x73\xba\xfb\x8c\xf0\x17\x49\xdc\xe1\x92\x7e\x97\xcf\xd8\x3b\x1c\xf8\xbe\xfe\x8e\xcc\x7a\xac\xd5\xf2\xa8\x6d\x3f\x7f\xee\x3d\xed\x59\xad\x6c\xf2\xe2\x1b\xb3\x68\x87\x68\x01\xcc\xd5\x7e\x56\x93\xab\xbd\xed\x3c\x16\x16\x2e\xd8\xd4\x76\x87\x26\xec\xe5\x75\xba\xff\xbc\xb4\x33\x94\xf4\x63\x51\xc7\xce\x48\x2a\x3f\x5a\xbe\xe1\xc3\xe2\x9d\x43\x45\x2b\xf8\x45\x77\xcf\x4d\x3b\xb8\xdf\xbb\xa9\x52\x58\x99\x9d\x1b\x1d\x3e\xa3\x82\x7e\x71\xfc\x3d\x41\x5b\xf8\xb5\x92\x7b\xa3\x66\x36\x66\xed\x5b\x7f\x8f\xc9\x39\x32\x65\x24\xfd\xd2\xde\x6f\xd7\x94\x49\x54\xee\xfc\x77\x27\x5e\x0d\x4e\x0f\xf9\x31\xcd\xc5\xd5\xba\x82\x92\xbd\x60\xfe\x02\xcf\xcd\xca\x0f\xea\xca\x26\x54\x35\xb2\x0e\x37\x45\x9c\xa8\x64\x95\xd2\xfc\x91\x52\x1a\x0f\xac\x55\x91\x92\x9a\xbf\xad\xf9\x25\x0e\x18\xb8\xf5\xf4\x3a\x39\x30\xba\x86\xa7\x6a\x6b\xc5\xb2\xc7\x2d\xaf\x91\x41\x1d\x31\xdd\x58\x8e\x98\x01\x67\xb3\x59\x5c\x0e\x9f\xc3\x4a\x42\x98\x1d\xb1\xd6\xac\x77\xac\xe9\x47\xdf\x66\x3c\xb9\xbb\x3a\xb8\x66\xf2\xbd\x36\xda\xe2\xc0\xbc\x0b\xc3\x57\xf4\xbf\x16\x28\x7a\x56\xb1\x73\xff\x2b\xc7\x08\xef\x51\x7d\x21\x2b\x0a\x3a\x25\x65\xb2\x66\x93\xdb\xce\x84\x80\x57\x4b\xab\xce\x09\x99\x2d\xfb\x8e\xcf\xde\xc1\x1d\xba\xe7\x78\xe3\xae\x55\x6d\x2d\x7c\x61\x60\xee\x13\xbb\x6f\x8a\x1c\xbc\x17\xf8\x67\x34\xf6\xd9\x94\xac\xa8\x7b\xb2\x3c\x25\xf1\x8c\x68\xd7\x77\xf3\x6e\xb5\xa6\x72\x9a\x04\x5f\xf7\x2a\x9f\xb9\xe4\x49\xdb\x93\x97\x79\x49\x2f\xae\x0d\x8b\x14\x78\x4c\x97\x9c\xce\xfe\xe2\x8f\x7b\xa7\xf2\x43\xab\x5e\x72\xf7\x3e\xbd\x53\x34\x54\x3e\xf2\xcd\x36\x64\xde\x0d\xee\xa8\x13\x67\xb5\x9e\xdb\x0f\x16\x7c\xbc\xfa\x8f\xf2\xc1\x4d\xfc\x95\x8f\x27\xf4\xdd\xe0\xba\x67\xe3\x1a\x4d\xfd\xe3\xe6\x2c\xbb\x27\x8d\xea\xdb\xb9\xe3\x1b\xf6\x0d\x18\xbc\xf6\x72\xf5\xec\xb4\x65\xa9\x6f\xb2\xbe\xad\x2c\xdb\x73\x9e\xfe\x20\xee\xd5\x05\x67\x79\x45\x78\xad\x66\x70\x6d\xb1\x4b\xc3\x8e\xf7\x0e\xee\xf8\x65\xff\xc5\xa0\xef\xc6\xcf\x69\x5e\x92\xb0\xf4\x46\x76\xfd\x8f\x2d\x67\x1a\x1f\x6d\x59\xc4\x1e\xec\xac\xca\xdb\x3f\xa6\x57\x4a\xee\x06\xf1\xd4\x01\x11\x9b\x3f\x47\xe2\x3d\x26\x8f\xe7\x27\x5e\xef\x33\x72\xeb\xc5\xc2\x6b\xf3\x9f\x6d\x1c\x7e\xa0\xef\x96\xdf\x12\x63\x2f\x6f\x8f\xfb\x76\xfe\x42\x65\xa9\xc6\x8a\x7d\xa1\x7a\xc6\xd8\x99\xf0\x3e\xa0\xe5\xb2\x5c\x96\xcb\x72\x59\x2e\xcb\x65\xb9\x2c\x97\xe5\xb2\x5c\x96\xcb\x72\x59\x2e\xcb\x65\xb9\x2c\x97\xe5\xb2\x5c\xe8\xf5\x7f\x01\x00\x00\xff\xff\x67\x8a\x78\x49\xb0\x6b\x01\x00")
-
-func alerterBytes() ([]byte, error) {
-	return bindataRead(
-		_alerter,
-		"alerter",
-	)
-}
-
-func bindataRead(data []byte, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(bytes.NewBuffer(data))
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
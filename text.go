@@ -0,0 +1,154 @@
+package gosxalerter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/rivo/uniseg"
+)
+
+// Ellipsis ends texts shortened by Truncate.
+const Ellipsis = "…"
+
+// Truncate shortens s to max graphemes, its last one being Ellipsis.
+// Graphemes, such as emoji or letters with combining accents, are never
+// split. s is returned unchanged when short enough or when max is 0.
+func Truncate(s string, max int) string {
+	if max <= 0 || uniseg.GraphemeClusterCount(s) <= max {
+		return s
+	}
+
+	var b strings.Builder
+	state := -1
+	rest := s
+	for i := 0; i < max-1; i++ {
+		var cluster string
+		cluster, rest, _, state = uniseg.FirstGraphemeClusterInString(rest, state)
+		b.WriteString(cluster)
+	}
+	return strings.TrimRightFunc(b.String(), isSpace) + Ellipsis
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '\r'
+}
+
+// Render returns a copy of the options whose Title, Subtitle, Message and
+// Actions are rendered as text/template templates with vars.
+func (o *Options) Render(vars interface{}) (*Options, error) {
+	t, err := parseOptionsTemplate(o)
+	if err != nil {
+		return nil, err
+	}
+	return t.execute(vars)
+}
+
+// optionsTemplate holds options whose Title, Subtitle, Message and Actions
+// are parsed as text/template templates.
+type optionsTemplate struct {
+	options  *Options
+	title    *template.Template
+	subtitle *template.Template
+	message  *template.Template
+	actions  []*template.Template
+}
+
+// parseOptionsTemplate parses the texts of o, errors are *FieldError.
+func parseOptionsTemplate(o *Options) (*optionsTemplate, error) {
+	t := &optionsTemplate{options: o.Clone()}
+	var errs []error
+	parse := func(field, text string) *template.Template {
+		tmpl, err := template.New(field).Option("missingkey=error").Parse(text)
+		if err != nil {
+			errs = append(errs, &FieldError{Field: field, Reason: err.Error()})
+		}
+		return tmpl
+	}
+	t.title = parse("Title", o.Title)
+	t.subtitle = parse("Subtitle", o.Subtitle)
+	t.message = parse("Message", o.Message)
+	for i, action := range o.Actions {
+		t.actions = append(t.actions, parse(fmt.Sprintf("Actions[%d]", i), action))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// execute returns a copy of the options rendered with vars, errors are
+// *FieldError.
+func (t *optionsTemplate) execute(vars interface{}) (*Options, error) {
+	o := t.options.Clone()
+	var errs []error
+	render := func(tmpl *template.Template, text string) string {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, vars); err != nil {
+			errs = append(errs, &FieldError{Field: tmpl.Name(), Reason: err.Error()})
+			return text
+		}
+		return buf.String()
+	}
+	o.Title = render(t.title, o.Title)
+	o.Subtitle = render(t.subtitle, o.Subtitle)
+	o.Message = render(t.message, o.Message)
+	for i, tmpl := range t.actions {
+		o.Actions[i] = render(tmpl, o.Actions[i])
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// WithTemplate renders the Title, Subtitle, Message and Actions of the
+// alert as text/template templates with vars, as Options.Render does.
+// Rendering errors are reported by New or Derive.
+func WithTemplate(vars interface{}) Option {
+	return func(a *Alert) {
+		r, err := a.Options.Render(vars)
+		if err != nil {
+			a.optionsErr = errors.Join(a.optionsErr, err)
+			return
+		}
+		a.Options = r
+	}
+}
+
+// Fit returns a copy of the options whose texts are truncated to limits.
+func (o *Options) Fit(limits Limits) *Options {
+	f := o.Clone()
+	f.Title = Truncate(o.Title, limits.Title)
+	f.Subtitle = Truncate(o.Subtitle, limits.Subtitle)
+	f.Message = Truncate(o.Message, limits.Message)
+	return f
+}
+
+// fitOptions truncates the options to the limits of backend, if any.
+func fitOptions(backend Backend, opts *Options) *Options {
+	if limited, ok := backend.(LimitedBackend); ok {
+		return opts.Fit(limited.Limits())
+	}
+	return opts
+}
+
+// Preview returns what the backend of the alert would send to the
+// notification system, after validation and truncation, without
+// delivering the alert: the command line for AlerterBackend.
+func (a *Alert) Preview() (interface{}, error) {
+	backend := a.backend()
+	if backend == nil {
+		return nil, ErrNoBackend
+	}
+	previewer, ok := backend.(Previewer)
+	if !ok {
+		return nil, errors.New("backend does not support previews")
+	}
+	if err := a.Options.validateFor(backend, !a.uncheckedImages); err != nil {
+		return nil, err
+	}
+	return previewer.Preview(fitOptions(backend, a.Options))
+}
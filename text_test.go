@@ -0,0 +1,123 @@
+package gosxalerter_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+)
+
+func TestRenderMatchesRegistry(t *testing.T) {
+	tmpl := &gosxalerter.Options{
+		Title:    "Deploy {{.Service}}",
+		Subtitle: "{{.Env}}",
+		Message:  "Deploy {{.Version}} ?",
+		Actions:  []string{"Deploy {{.Version}}", "Later"},
+	}
+	vars := map[string]string{"Service": "api", "Version": "v1.2", "Env": "prod"}
+
+	rendered, err := tmpl.Render(vars)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(rendered.Actions, ","); got != "Deploy v1.2,Later" {
+		t.Errorf("actions %q, want Deploy v1.2,Later", got)
+	}
+	if tmpl.Actions[0] != "Deploy {{.Version}}" {
+		t.Errorf("Render modified the template actions: %q", tmpl.Actions[0])
+	}
+
+	reg := gosxalerter.NewRegistry()
+	if err := reg.Add("deploy", tmpl); err != nil {
+		t.Fatal(err)
+	}
+	a, err := reg.New("deploy", vars)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(a.Options.Actions, rendered.Actions) || a.Options.Title != rendered.Title ||
+		a.Options.Subtitle != rendered.Subtitle || a.Options.Message != rendered.Message {
+		t.Errorf("registry rendered %+v, Render rendered %+v", a.Options, rendered)
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	tmpl := &gosxalerter.Options{Message: "{{.Missing}}", Actions: []string{"{{"}}
+	_, err := tmpl.Render(map[string]string{})
+	var fieldErr *gosxalerter.FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Field != "Actions[0]" {
+		t.Fatalf("error = %v, want an Actions[0] *FieldError", err)
+	}
+
+	tmpl.Actions = nil
+	_, err = tmpl.Render(map[string]string{})
+	if !errors.As(err, &fieldErr) || fieldErr.Field != "Message" {
+		t.Fatalf("error = %v, want a Message *FieldError", err)
+	}
+}
+
+func TestAlerterBackendLimits(t *testing.T) {
+	message := strings.Repeat("long message ", 40)
+	for _, tt := range []struct {
+		limits gosxalerter.Limits
+		want   int
+	}{
+		{gosxalerter.Limits{}, len([]rune(message))},
+		{gosxalerter.AlerterLimits, gosxalerter.AlerterLimits.Message},
+	} {
+		backend := &gosxalerter.AlerterBackend{Path: "alerter", TextLimits: tt.limits}
+		a, err := gosxalerter.New(message, gosxalerter.WithBackend(backend))
+		if err != nil {
+			t.Fatal(err)
+		}
+		preview, err := a.Preview()
+		if err != nil {
+			t.Fatal(err)
+		}
+		argv := preview.([]string)
+		var got string
+		for i, arg := range argv {
+			if arg == "-message" && i+1 < len(argv) {
+				got = argv[i+1]
+			}
+		}
+		if n := len([]rune(got)); n != tt.want {
+			t.Errorf("limits %+v: message of %d runes, want %d", tt.limits, n, tt.want)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	const (
+		thumbsUp = "\U0001F44D\U0001F3FD"                       // with a skin tone modifier
+		family   = "\U0001F468\u200d\U0001F469\u200d\U0001F467" // zero width joined
+		accented = "e\u0301"                                    // e and a combining acute accent
+		flag     = "\U0001F1EB\U0001F1F7"                       // regional indicators of FR
+	)
+	tests := []struct {
+		s    string
+		max  int
+		want string
+	}{
+		{"hello world", 0, "hello world"},
+		{"hello world", -1, "hello world"},
+		{"hello world", 1, gosxalerter.Ellipsis},
+		{"h", 1, "h"},
+		{"hello world", 11, "hello world"},
+		{"hello world", 7, "hello" + gosxalerter.Ellipsis},
+		{"ok " + thumbsUp + thumbsUp, 5, "ok " + thumbsUp + thumbsUp},
+		{"ok " + thumbsUp + thumbsUp + thumbsUp, 5, "ok " + thumbsUp + gosxalerter.Ellipsis},
+		{family + family + family, 2, family + gosxalerter.Ellipsis},
+		{"caf" + accented + " cr" + accented + "me", 5, "caf" + accented + gosxalerter.Ellipsis},
+		{"caf" + accented + accented, 5, "caf" + accented + accented},
+		{flag + flag + flag, 2, flag + gosxalerter.Ellipsis},
+		{flag, 1, flag},
+	}
+	for _, tt := range tests {
+		if got := gosxalerter.Truncate(tt.s, tt.max); got != tt.want {
+			t.Errorf("Truncate(%q, %d) = %q, want %q", tt.s, tt.max, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package gosxalerter
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+)
+
+// FieldError reports an invalid Options field, Validate joins them.
+type FieldError struct {
+	Field  string // Name of the Options field
+	Reason string
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Reason
+}
+
+// macOSSounds are the sounds known by AlerterBackend.
+var macOSSounds = map[Sound]bool{
+	SoundDefault:   true,
+	SoundBasso:     true,
+	SoundBlow:      true,
+	SoundBottle:    true,
+	SoundFrog:      true,
+	SoundFunk:      true,
+	SoundGlass:     true,
+	SoundHero:      true,
+	SoundMorse:     true,
+	SoundPing:      true,
+	SoundPop:       true,
+	SoundPurr:      true,
+	SoundSosumi:    true,
+	SoundSubmarine: true,
+	SoundTink:      true,
+}
+
+// bundleIDPattern matches reverse-DNS bundle identifiers such as
+// com.apple.Terminal.
+var bundleIDPattern = regexp.MustCompile(`^[A-Za-z0-9-]+(\.[A-Za-z0-9-]+)+$`)
+
+// Validate checks the options, and returns every problem found as
+// *FieldError joined in a single error, nil when the options are valid.
+// Sounds are checked by the backend, see ValidatingBackend.
+func (o *Options) Validate() error {
+	return o.validate(true)
+}
+
+// validate checks the options, the images given as local paths when
+// checkImages is set.
+func (o *Options) validate(checkImages bool) error {
+	var errs []error
+	invalid := func(field, format string, args ...interface{}) {
+		errs = append(errs, &FieldError{Field: field, Reason: fmt.Sprintf(format, args...)})
+	}
+
+	if o.Message == "" {
+		invalid("Message", "required")
+	}
+	if o.Reply && len(o.Actions) > 0 {
+		invalid("Reply", "can not be combined with Actions")
+	}
+	if o.DropdownLabel != "" && len(o.Actions) < 2 {
+		invalid("DropdownLabel", "requires more than one action")
+	}
+	if o.Timeout < 0 {
+		invalid("Timeout", "must not be negative, got %s", o.Timeout)
+	}
+	for i, action := range o.Actions {
+		if action == "" {
+			invalid("Actions", "action %d is empty", i)
+		}
+	}
+	if o.AppIcon != "" && checkImages {
+		if err := checkImage(o.AppIcon); err != nil {
+			invalid("AppIcon", "%s", err)
+		}
+	}
+	if o.ContentImage != "" && checkImages {
+		if err := checkImage(o.ContentImage); err != nil {
+			invalid("ContentImage", "%s", err)
+		}
+	}
+	if o.Sender != "" && !bundleIDPattern.MatchString(o.Sender) {
+		invalid("Sender", "%q is not a bundle identifier", o.Sender)
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateFor checks the options as validate does, images being checked
+// unless backend is a RemoteBackend, then the options accepted by backend
+// when it is a ValidatingBackend.
+func (o *Options) validateFor(backend Backend, checkImages bool) error {
+	if remote, ok := backend.(RemoteBackend); ok && remote.Remote() {
+		checkImages = false
+	}
+	err := o.validate(checkImages)
+	if validating, ok := backend.(ValidatingBackend); ok {
+		err = errors.Join(err, validating.Validate(o))
+	}
+	return err
+}
+
+// checkImage checks that an image given as a local path, or file URL, is
+// readable. Remote URLs are not fetched.
+func checkImage(image string) error {
+	path := image
+	if u, err := url.Parse(image); err == nil && u.Scheme != "" {
+		if u.Scheme != "file" {
+			return nil
+		}
+		path = u.Path
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("unreadable image: %w", err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,119 @@
+package gosxalerter_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+	"github.com/vjeantet/gosx-alerter/gosxalertertest"
+)
+
+func TestSoundValidation(t *testing.T) {
+	alerter := &gosxalerter.AlerterBackend{}
+	fake := gosxalertertest.New()
+
+	tests := []struct {
+		name    string
+		backend gosxalerter.Backend
+		sound   gosxalerter.Sound
+		valid   bool
+	}{
+		{"osx sound", alerter, gosxalerter.SoundSubmarine, true},
+		{"osx default", alerter, gosxalerter.SoundDefault, true},
+		{"freedesktop sound on osx", alerter, "message-new-instant", false},
+		{"freedesktop sound", fake, "message-new-instant", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := gosxalerter.New("hello",
+				gosxalerter.WithBackend(tt.backend),
+				gosxalerter.WithSound(tt.sound),
+			)
+			if tt.valid {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var fieldErr *gosxalerter.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Field != "Sound" {
+				t.Fatalf("error = %v, want a Sound *FieldError", err)
+			}
+		})
+	}
+}
+
+// fieldsOf returns the Field of every *FieldError joined in err.
+func fieldsOf(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("error %v does not join errors", err)
+	}
+	var fields []string
+	for _, err := range joined.Unwrap() {
+		var fieldErr *gosxalerter.FieldError
+		if !errors.As(err, &fieldErr) {
+			t.Fatalf("error %v is not a *FieldError", err)
+		}
+		fields = append(fields, fieldErr.Field)
+	}
+	return fields
+}
+
+func TestValidate(t *testing.T) {
+	icon := filepath.Join(t.TempDir(), "icon.png")
+	if err := os.WriteFile(icon, []byte("png"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	missing := filepath.Join(t.TempDir(), "missing.png")
+
+	tests := []struct {
+		name string
+		opts gosxalerter.Options
+		want []string
+	}{
+		{"valid", gosxalerter.Options{
+			Message:       "hello",
+			Actions:       []string{"Yes", "No"},
+			DropdownLabel: "Choose",
+			AppIcon:       icon,
+			ContentImage:  "file://" + icon,
+			Sender:        "com.apple.Terminal",
+			Timeout:       time.Second,
+		}, nil},
+		{"remote images", gosxalerter.Options{Message: "hello", AppIcon: "https://example.com/icon.png"}, nil},
+		{"empty message", gosxalerter.Options{}, []string{"Message"}},
+		{"reply with actions", gosxalerter.Options{Message: "hello", Reply: true, Actions: []string{"Yes"}}, []string{"Reply"}},
+		{"dropdown of one action", gosxalerter.Options{Message: "hello", Actions: []string{"Yes"}, DropdownLabel: "Choose"}, []string{"DropdownLabel"}},
+		{"dropdown without actions", gosxalerter.Options{Message: "hello", DropdownLabel: "Choose"}, []string{"DropdownLabel"}},
+		{"negative timeout", gosxalerter.Options{Message: "hello", Timeout: -time.Second}, []string{"Timeout"}},
+		{"empty action", gosxalerter.Options{Message: "hello", Actions: []string{"Yes", ""}}, []string{"Actions"}},
+		{"unreadable app icon", gosxalerter.Options{Message: "hello", AppIcon: missing}, []string{"AppIcon"}},
+		{"unreadable content image", gosxalerter.Options{Message: "hello", ContentImage: "file://" + missing}, []string{"ContentImage"}},
+		{"invalid sender", gosxalerter.Options{Message: "hello", Sender: "Terminal"}, []string{"Sender"}},
+		{"every problem", gosxalerter.Options{
+			Reply:         true,
+			Actions:       []string{"Yes"},
+			DropdownLabel: "Choose",
+			Timeout:       -time.Second,
+			AppIcon:       missing,
+			ContentImage:  missing,
+			Sender:        "com apple",
+		}, []string{"Message", "Reply", "DropdownLabel", "Timeout", "AppIcon", "ContentImage", "Sender"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fieldsOf(t, tt.opts.Validate()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("invalid fields %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package gosxalerter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default labels of the actions of the alert delivered by Watch.
+const (
+	DefaultShowLogAction = "Show log"
+	DefaultRerunAction   = "Rerun"
+)
+
+// maxTailLine limits the length of an output line kept for the message of
+// the alert.
+const maxTailLine = 1024
+
+// WatchOptions configures Watch.
+type WatchOptions struct {
+	Lines         int      // Number of last output lines in the message, defaults to 5, must not be negative
+	ShowLogAction string   // Label of the action keeping the full log, defaults to DefaultShowLogAction
+	RerunAction   string   // Label of the action running the command again, defaults to DefaultRerunAction
+	LogDir        string   // Directory of the logs, defaults to os.TempDir()
+	Alert         []Option // Options of the alert, applied over the ones set by Watch
+}
+
+// WatchResult tells how the watched command ended, and how the user
+// answered the alert.
+type WatchResult struct {
+	Err        error         // Error returned by cmd.Wait, nil when the command succeeded
+	ExitCode   int           // Exit code of the command, -1 when killed by a signal
+	Duration   time.Duration // Run duration of the command
+	Tail       []string      // Last non blank lines of the combined stdout and stderr of the command
+	Runs       int           // Number of runs, more than 1 when the user asked to rerun
+	LogPath    string        // Path of the full log, kept when the user asked for it
+	Activation *Activation
+}
+
+// Watch runs cmd, then delivers an alert telling its exit status, its
+// duration and the last lines of its output. The alert has a "Show log"
+// action, keeping the full output in a file of LogDir, and a "Rerun"
+// action, running the command again then delivering a new alert.
+//
+// The output of cmd is streamed to the log file, only its last lines are
+// kept in memory, and is still copied to cmd.Stdout and cmd.Stderr when
+// they are set. The log file is removed unless the user asked for it.
+// When ctx is done, the command is killed and ctx.Err() is returned. A
+// failing command is not an error, it is reported by the WatchResult.
+func Watch(ctx context.Context, cmd *exec.Cmd, opts WatchOptions) (*WatchResult, error) {
+	if opts.Lines < 0 {
+		return nil, fmt.Errorf("invalid WatchOptions.Lines %d: must not be negative", opts.Lines)
+	}
+	if opts.Lines == 0 {
+		opts.Lines = 5
+	}
+	if opts.ShowLogAction == "" {
+		opts.ShowLogAction = DefaultShowLogAction
+	}
+	if opts.RerunAction == "" {
+		opts.RerunAction = DefaultRerunAction
+	}
+	if opts.LogDir == "" {
+		opts.LogDir = os.TempDir()
+	}
+	stdout, stderr := cmd.Stdout, cmd.Stderr
+
+	for runs := 1; ; runs++ {
+		result, err := runWatched(ctx, cmd, opts)
+		if err != nil {
+			return nil, err
+		}
+		result.Runs = runs
+		removeLog := func() {
+			os.Remove(result.LogPath)
+			result.LogPath = ""
+		}
+
+		alertOpts := []Option{
+			WithTitle(commandLine(cmd)),
+			WithSubtitle(result.status()),
+			WithActions(opts.ShowLogAction, opts.RerunAction),
+		}
+		message := strings.Join(result.Tail, "\n")
+		if message == "" {
+			message = "No output"
+		}
+		alert, err := New(message, append(alertOpts, opts.Alert...)...)
+		if err != nil {
+			removeLog()
+			return result, err
+		}
+		result.Activation, err = alert.DeliverAndWaitContext(ctx)
+		if err != nil {
+			removeLog()
+			return result, err
+		}
+
+		switch {
+		case result.Activation.IsAction(opts.RerunAction):
+			removeLog()
+			cmd = rerunCommand(cmd, stdout, stderr)
+		case result.Activation.IsAction(opts.ShowLogAction):
+			return result, nil
+		default:
+			removeLog()
+			return result, nil
+		}
+	}
+}
+
+// runWatched runs cmd, streaming its output to a new log file in
+// opts.LogDir and keeping its last opts.Lines lines.
+func runWatched(ctx context.Context, cmd *exec.Cmd, opts WatchOptions) (*WatchResult, error) {
+	logFile, err := ioutil.TempFile(opts.LogDir, filepath.Base(cmd.Path)+"-*.log")
+	if err != nil {
+		return nil, err
+	}
+	defer logFile.Close()
+	tail := &tailWriter{n: opts.Lines}
+	output := &lockedWriter{w: io.MultiWriter(logFile, tail)}
+	cmd.Stdout = teeWriter(cmd.Stdout, output)
+	cmd.Stderr = teeWriter(cmd.Stderr, output)
+
+	start := time.Now()
+	if err := cmd.Start(); err != nil {
+		os.Remove(logFile.Name())
+		return nil, err
+	}
+	stop := context.AfterFunc(ctx, func() { cmd.Process.Kill() })
+	waitErr := cmd.Wait()
+	if !stop() {
+		os.Remove(logFile.Name())
+		return nil, ctx.Err()
+	}
+
+	result := &WatchResult{
+		Err:      waitErr,
+		Duration: time.Since(start),
+		Tail:     tail.Lines(),
+		LogPath:  logFile.Name(),
+	}
+	var exitErr *exec.ExitError
+	switch {
+	case waitErr == nil:
+	case errors.As(waitErr, &exitErr):
+		result.ExitCode = exitErr.ExitCode()
+	default:
+		result.ExitCode = -1
+	}
+	return result, nil
+}
+
+// status returns the exit status and the duration of the run.
+func (r *WatchResult) status() string {
+	d := r.Duration.Round(time.Millisecond)
+	if d > time.Second {
+		d = d.Round(time.Second)
+	}
+	switch {
+	case r.Err == nil:
+		return fmt.Sprintf("Succeeded in %s", d)
+	case r.ExitCode > 0:
+		return fmt.Sprintf("Failed with exit code %d in %s", r.ExitCode, d)
+	}
+	return fmt.Sprintf("Failed in %s: %v", d, r.Err)
+}
+
+func commandLine(cmd *exec.Cmd) string {
+	args := append([]string{filepath.Base(cmd.Path)}, cmd.Args[1:]...)
+	return strings.Join(args, " ")
+}
+
+// rerunCommand returns a command running cmd again, writing to the
+// original stdout and stderr of cmd.
+func rerunCommand(cmd *exec.Cmd, stdout, stderr io.Writer) *exec.Cmd {
+	return &exec.Cmd{
+		Path:        cmd.Path,
+		Args:        cmd.Args,
+		Env:         cmd.Env,
+		Dir:         cmd.Dir,
+		Stdin:       cmd.Stdin,
+		Stdout:      stdout,
+		Stderr:      stderr,
+		ExtraFiles:  cmd.ExtraFiles,
+		SysProcAttr: cmd.SysProcAttr,
+	}
+}
+
+func teeWriter(w io.Writer, output io.Writer) io.Writer {
+	if w == nil {
+		return output
+	}
+	return io.MultiWriter(w, output)
+}
+
+// lockedWriter serializes the writes of the stdout and stderr copying
+// goroutines of a command.
+type lockedWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (l *lockedWriter) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.w.Write(p)
+}
+
+// tailWriter keeps the last n non blank lines written to it, each one cut
+// to maxTailLine bytes. Older lines are dropped as new ones are written.
+type tailWriter struct {
+	n       int
+	lines   []string
+	partial []byte
+}
+
+func (t *tailWriter) Write(p []byte) (int, error) {
+	for _, c := range p {
+		if c == '\n' {
+			t.push()
+			continue
+		}
+		if len(t.partial) < maxTailLine {
+			t.partial = append(t.partial, c)
+		}
+	}
+	return len(p), nil
+}
+
+// push ends the partial line.
+func (t *tailWriter) push() {
+	line := tailLine(t.partial)
+	t.partial = t.partial[:0]
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+	t.lines = append(t.lines, line)
+	if len(t.lines) > t.n {
+		t.lines = append(t.lines[:0], t.lines[len(t.lines)-t.n:]...)
+	}
+}
+
+// Lines returns the kept lines, ending with the unterminated last line.
+func (t *tailWriter) Lines() []string {
+	lines := append([]string(nil), t.lines...)
+	if line := tailLine(t.partial); strings.TrimSpace(line) != "" {
+		lines = append(lines, line)
+	}
+	if len(lines) > t.n {
+		lines = lines[len(lines)-t.n:]
+	}
+	return lines
+}
+
+// tailLine returns a kept line, whose last rune may have been cut by
+// maxTailLine.
+func tailLine(b []byte) string {
+	return strings.ToValidUTF8(strings.TrimRight(string(b), "\r"), "")
+}
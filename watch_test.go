@@ -0,0 +1,100 @@
+package gosxalerter_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	gosxalerter "github.com/vjeantet/gosx-alerter"
+	"github.com/vjeantet/gosx-alerter/gosxalertertest"
+)
+
+func TestWatch(t *testing.T) {
+	backend := gosxalertertest.New()
+	backend.ClickAction(1) // Rerun
+	backend.ClickAction(0) // Show log
+
+	cmd := exec.Command("sh", "-c", "for i in 1 2 3 4 5 6 7; do echo line $i; done; exit 3")
+	result, err := gosxalerter.Watch(context.Background(), cmd, gosxalerter.WatchOptions{
+		Lines:  3,
+		LogDir: t.TempDir(),
+		Alert:  []gosxalerter.Option{gosxalerter.WithBackend(backend)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Runs != 2 || result.ExitCode != 3 {
+		t.Errorf("runs %d, exit code %d, want 2 runs and exit code 3", result.Runs, result.ExitCode)
+	}
+	delivered := backend.Delivered()
+	if len(delivered) != 2 {
+		t.Fatalf("delivered %d alerts, want 2", len(delivered))
+	}
+	if got, want := delivered[1].Message, "line 5\nline 6\nline 7"; got != want {
+		t.Errorf("message %q, want %q", got, want)
+	}
+	if !strings.HasPrefix(delivered[1].Subtitle, "Failed with exit code 3") {
+		t.Errorf("subtitle %q", delivered[1].Subtitle)
+	}
+
+	log, err := os.ReadFile(result.LogPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(string(log), "\n"); n != 7 {
+		t.Errorf("log has %d lines, want 7", n)
+	}
+}
+
+func TestWatchRemovesLog(t *testing.T) {
+	backend := gosxalertertest.New()
+	backend.Dismiss()
+	dir := t.TempDir()
+
+	result, err := gosxalerter.Watch(context.Background(), exec.Command("true"), gosxalerter.WatchOptions{
+		LogDir: dir,
+		Alert:  []gosxalerter.Option{gosxalerter.WithBackend(backend)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.LogPath != "" {
+		t.Errorf("log path %q, want none", result.LogPath)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("log directory holds %d files, want none", len(entries))
+	}
+	if got := backend.Delivered()[0].Message; got != "No output" {
+		t.Errorf("message %q, want %q", got, "No output")
+	}
+}
+
+func TestWatchLongOutput(t *testing.T) {
+	backend := gosxalertertest.New()
+	backend.Dismiss()
+
+	// a single 100 KiB line without newline, then many lines
+	script := fmt.Sprintf("head -c %d /dev/zero | tr '\\\\0' x; echo; seq 1 10000", 100<<10)
+	_, err := gosxalerter.Watch(context.Background(), exec.Command("sh", "-c", script), gosxalerter.WatchOptions{
+		Lines:  2,
+		LogDir: t.TempDir(),
+		Alert:  []gosxalerter.Option{gosxalerter.WithBackend(backend)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := backend.Delivered()[0].Message, "9999\n10000"; got != want {
+		t.Errorf("message %q, want %q", got, want)
+	}
+}
+
+func TestWatchNegativeLines(t *testing.T) {
+	_, err := gosxalerter.Watch(context.Background(), exec.Command("true"), gosxalerter.WatchOptions{Lines: -1})
+	if err == nil {
+		t.Fatal("negative Lines accepted")
+	}
+}